@@ -0,0 +1,189 @@
+package main
+
+import (
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func isCommonType(message protoreflect.FullName) bool {
+	switch message {
+	case "google.type.Date",
+		"google.type.TimeOfDay",
+		"google.type.LatLng",
+		"google.type.Money",
+		"google.type.Color",
+		"google.type.PostalAddress":
+		return true
+	}
+	return false
+}
+
+// inferCommonTypeField infers a field for a google.type common type.
+//
+// Date, TimeOfDay, LatLng, Money and Color values are stored in the format of their widget,
+// see package decapjson.
+func inferCommonTypeField(
+	field *cmsv1.Field,
+	protoField *protogen.Field,
+	parentFields []*protogen.Field,
+	resources resourceIndex,
+) (*cmsv1.Field, bool) {
+	widget, ok := inferCommonTypeWidget(protoField, parentFields, resources)
+	if !ok {
+		return nil, false
+	}
+	if protoField.Desc.IsList() {
+		return inferListField(field, protoField, widget), true
+	}
+	if objectWidget, ok := widget.GetWidgetType().(*cmsv1.Widget_ObjectWidget); ok {
+		objectWidget.ObjectWidget.Collapsed = !inferRequired(protoField)
+	}
+	field.Widget.WidgetType = widget.GetWidgetType()
+	if field.Widget.Pattern == nil {
+		field.Widget.Pattern = widget.GetPattern()
+	}
+	return field, true
+}
+
+func inferCommonTypeWidget(
+	protoField *protogen.Field,
+	parentFields []*protogen.Field,
+	resources resourceIndex,
+) (*cmsv1.Widget, bool) {
+	switch protoField.Desc.Message().FullName() {
+	case "google.type.Date":
+		return &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_DateTimeWidget{
+				DateTimeWidget: &cmsv1.DateTimeWidget{
+					Format:            "YYYY-MM-DD",
+					DateFormat:        "YYYY-MM-DD",
+					DisableTimeFormat: true,
+					PickerUtc:         true,
+				},
+			},
+		}, true
+	case "google.type.TimeOfDay":
+		return &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_DateTimeWidget{
+				DateTimeWidget: &cmsv1.DateTimeWidget{
+					Format:            "HH:mm:ss",
+					TimeFormat:        "HH:mm:ss",
+					DisableDateFormat: true,
+					PickerUtc:         true,
+				},
+			},
+		}, true
+	case "google.type.LatLng":
+		return &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_MapWidget{
+				MapWidget: &cmsv1.MapWidget{
+					Decimals: 7,
+					Type:     cmsv1.MapWidget_POINT,
+				},
+			},
+		}, true
+	case "google.type.Color":
+		return &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_ColorWidget{
+				ColorWidget: &cmsv1.ColorWidget{
+					AllowInput:  true,
+					EnableAlpha: true,
+				},
+			},
+		}, true
+	case "google.type.Money":
+		options := make([]*cmsv1.SelectWidget_Option, 0, len(currencyCodes))
+		for _, currencyCode := range currencyCodes {
+			options = append(options, &cmsv1.SelectWidget_Option{
+				Label: currencyCode,
+				Value: currencyCode,
+			})
+		}
+		return &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_ObjectWidget{
+				ObjectWidget: &cmsv1.ObjectWidget{
+					Summary: "{{fields.amount}} {{fields.currency_code}}",
+					Fields: []*cmsv1.Field{
+						{
+							Name:  "currency_code",
+							Label: "CURRENCY",
+							Widget: &cmsv1.Widget{
+								RequiredValue: true,
+								WidgetType: &cmsv1.Widget_SelectWidget{
+									SelectWidget: &cmsv1.SelectWidget{
+										Options: options,
+									},
+								},
+							},
+						},
+						{
+							Name:  "amount",
+							Label: "AMOUNT",
+							Widget: &cmsv1.Widget{
+								RequiredValue: true,
+								Pattern: &cmsv1.Widget_Pattern{
+									Regexp:       `^-?[0-9]+(\.[0-9]{1,9})?$`,
+									ErrorMessage: "Must be a decimal amount, such as 12.50",
+								},
+								WidgetType: &cmsv1.Widget_StringWidget{
+									StringWidget: &cmsv1.StringWidget{},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, true
+	case "google.type.PostalAddress":
+		objectFields := make([]*cmsv1.Field, 0, len(protoField.Message.Fields))
+		for _, protoObjectField := range protoField.Message.Fields {
+			switch protoObjectField.Desc.Name() {
+			case "revision", "language_code", "sorting_code":
+				continue
+			}
+			objectField, ok := inferField(
+				protoField.Message, protoObjectField, append(parentFields, protoField), resources,
+			)
+			if !ok {
+				continue
+			}
+			// the comments of the common types are too verbose to be used as hints
+			objectField.Comment = ""
+			objectField.Widget.Hint = ""
+			if protoObjectField.Desc.Name() == "region_code" {
+				objectField.Widget.RequiredValue = true
+			}
+			objectFields = append(objectFields, objectField)
+		}
+		return &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_ObjectWidget{
+				ObjectWidget: &cmsv1.ObjectWidget{
+					Summary: "{{fields.locality}}, {{fields.region_code}}",
+					Fields:  objectFields,
+				},
+			},
+		}, true
+	}
+	return nil, false
+}
+
+// currencyCodes are the ISO 4217 currency codes selectable for google.type.Money.
+var currencyCodes = []string{
+	"AED", "AFN", "ALL", "AMD", "ANG", "AOA", "ARS", "AUD", "AWG", "AZN",
+	"BAM", "BBD", "BDT", "BGN", "BHD", "BIF", "BMD", "BND", "BOB", "BRL",
+	"BSD", "BTN", "BWP", "BYN", "BZD", "CAD", "CDF", "CHF", "CLP", "CNY",
+	"COP", "CRC", "CUP", "CVE", "CZK", "DJF", "DKK", "DOP", "DZD", "EGP",
+	"ERN", "ETB", "EUR", "FJD", "FKP", "GBP", "GEL", "GHS", "GIP", "GMD",
+	"GNF", "GTQ", "GYD", "HKD", "HNL", "HTG", "HUF", "IDR", "ILS", "INR",
+	"IQD", "IRR", "ISK", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF",
+	"KPW", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL",
+	"LYD", "MAD", "MDL", "MGA", "MKD", "MMK", "MNT", "MOP", "MRU", "MUR",
+	"MVR", "MWK", "MXN", "MYR", "MZN", "NAD", "NGN", "NIO", "NOK", "NPR",
+	"NZD", "OMR", "PAB", "PEN", "PGK", "PHP", "PKR", "PLN", "PYG", "QAR",
+	"RON", "RSD", "RUB", "RWF", "SAR", "SBD", "SCR", "SDG", "SEK", "SGD",
+	"SHP", "SLE", "SOS", "SRD", "SSP", "STN", "SVC", "SYP", "SZL", "THB",
+	"TJS", "TMT", "TND", "TOP", "TRY", "TTD", "TWD", "TZS", "UAH", "UGX",
+	"USD", "UYU", "UZS", "VES", "VND", "VUV", "WST", "XAF", "XCD", "XOF",
+	"XPF", "YER", "ZAR", "ZMW", "ZWL",
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	examplev1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/example/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestInferCommonTypeField_DateTime(t *testing.T) {
+	gen := newTestPlugin(t, examplev1.File_einride_decap_cms_example_v1_kitchen_sink_proto)
+	message := findTestMessage(t, gen, "einride.decap.cms.example.v1.KitchenSink")
+	resources := indexResources(message.Desc.ParentFile().Package(), gen.Files)
+	for _, tt := range []struct {
+		field    protoreflect.Name
+		expected map[string]any
+	}{
+		{
+			field: "date",
+			expected: map[string]any{
+				"widget":      "datetime",
+				"format":      "YYYY-MM-DD",
+				"date_format": "YYYY-MM-DD",
+				"time_format": false,
+				"picker_utc":  true,
+			},
+		},
+		{
+			field: "time_of_day",
+			expected: map[string]any{
+				"widget":      "datetime",
+				"format":      "HH:mm:ss",
+				"date_format": false,
+				"time_format": "HH:mm:ss",
+				"picker_utc":  true,
+			},
+		},
+	} {
+		t.Run(string(tt.field), func(t *testing.T) {
+			field, ok := inferField(message, findTestField(t, message, tt.field), nil, resources)
+			if !ok {
+				t.Fatal("expected field")
+			}
+			actual := genField(field)
+			for key, expected := range tt.expected {
+				if value, _ := actual.Get(key); !reflect.DeepEqual(expected, value) {
+					t.Errorf("%s: expected %v, got %v", key, expected, value)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// mapping is an ordered mapping of a generated Decap CMS config document.
+// Values are strings, bools, integers, floats, timestamps, nil, mappings and sequences.
+type mapping []mappingEntry
+
+type mappingEntry struct {
+	key   string
+	value any
+}
+
+// sequence is a sequence of a generated Decap CMS config document.
+type sequence []any
+
+// timestamp is a YAML timestamp of a generated Decap CMS config document, kept as written.
+type timestamp string
+
+// Set appends an entry to the mapping.
+func (m *mapping) Set(key string, value any) {
+	*m = append(*m, mappingEntry{key: key, value: value})
+}
+
+// Get returns the value of the first entry of the mapping with the key.
+func (m mapping) Get(key string) (any, bool) {
+	for _, entry := range m {
+		if entry.key == key {
+			return entry.value, true
+		}
+	}
+	return nil, false
+}
+
+// Append appends the entries of another mapping to the mapping.
+func (m *mapping) Append(other mapping) {
+	*m = append(*m, other...)
+}
+
+// marshalYAML marshals a config document to YAML, preceded by a header comment.
+func marshalYAML(document mapping, header string) ([]byte, error) {
+	root, err := yamlNode(document)
+	if err != nil {
+		return nil, err
+	}
+	var result bytes.Buffer
+	encoder := yaml.NewEncoder(&result)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&yaml.Node{
+		Kind:        yaml.DocumentNode,
+		HeadComment: header,
+		Content:     []*yaml.Node{root},
+	}); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return result.Bytes(), nil
+}
+
+func yamlNode(value any) (*yaml.Node, error) {
+	switch value := value.(type) {
+	case mapping:
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		for _, entry := range value {
+			valueNode, err := yamlNode(entry.value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", entry.key, err)
+			}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: entry.key}, valueNode)
+		}
+		return node, nil
+	case sequence:
+		node := &yaml.Node{Kind: yaml.SequenceNode}
+		for _, element := range value {
+			elementNode, err := yamlNode(element)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, elementNode)
+		}
+		return node, nil
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Style: yaml.DoubleQuotedStyle, Value: value}, nil
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(value)}, nil
+	case int:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(value)}, nil
+	case int64:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.FormatInt(value, 10)}, nil
+	case uint64:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.FormatUint(value, 10)}, nil
+	case float64:
+		formatted := formatNumber(value)
+		if strings.Contains(formatted, ".") {
+			return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: formatted}, nil
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: formatted}, nil
+	case timestamp:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!timestamp", Value: string(value)}, nil
+	case nil:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+	default:
+		return nil, fmt.Errorf("unsupported document value %T", value)
+	}
+}
+
+// documentValue converts a decoded JSON or YAML value to a document value, with mapping keys sorted.
+func documentValue(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		result := make(mapping, 0, len(value))
+		for _, key := range keys {
+			result.Set(key, documentValue(value[key]))
+		}
+		return result
+	case []any:
+		result := make(sequence, 0, len(value))
+		for _, element := range value {
+			result = append(result, documentValue(element))
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// parseYAMLMapping parses a YAML mapping to a document mapping, keeping the order of its keys.
+func parseYAMLMapping(data string) (mapping, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(data), &document); err != nil {
+		return nil, err
+	}
+	if len(document.Content) == 0 {
+		return nil, nil
+	}
+	value, err := yamlDocumentValue(document.Content[0])
+	if err != nil {
+		return nil, err
+	}
+	result, ok := value.(mapping)
+	if !ok {
+		return nil, fmt.Errorf("not a YAML mapping")
+	}
+	return result, nil
+}
+
+func yamlDocumentValue(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		result := make(mapping, 0, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			value, err := yamlDocumentValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			result.Set(node.Content[i].Value, value)
+		}
+		return result, nil
+	case yaml.SequenceNode:
+		result := make(sequence, 0, len(node.Content))
+		for _, element := range node.Content {
+			value, err := yamlDocumentValue(element)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, value)
+		}
+		return result, nil
+	case yaml.AliasNode:
+		return yamlDocumentValue(node.Alias)
+	default:
+		if node.ShortTag() == "!!timestamp" {
+			return timestamp(node.Value), nil
+		}
+		var value any
+		if err := node.Decode(&value); err != nil {
+			return nil, err
+		}
+		return value, nil
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"log"
+	"strings"
+
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// isEnumSelectField reports whether a field is an enum edited with an inferred or user-defined select widget,
+// or a repeated enum with a user-defined list widget, which is edited as a multiple select.
+func isEnumSelectField(field *cmsv1.Field, protoField *protogen.Field) bool {
+	if protoField.Desc.Kind() != protoreflect.EnumKind {
+		return false
+	}
+	switch field.GetWidget().GetWidgetType().(type) {
+	case nil, *cmsv1.Widget_SelectWidget:
+		return true
+	case *cmsv1.Widget_ListWidget:
+		return protoField.Desc.IsList()
+	}
+	return false
+}
+
+// checkEnumWidget fails on user-defined widgets of enum fields that can't hold enum value names, such
+// as number and boolean widgets, and list widgets of singular enum fields.
+func checkEnumWidget(field *cmsv1.Field, protoField *protogen.Field) {
+	if protoField.Desc.Kind() != protoreflect.EnumKind {
+		return
+	}
+	switch field.GetWidget().GetWidgetType().(type) {
+	case *cmsv1.Widget_NumberWidget, *cmsv1.Widget_BooleanWidget, *cmsv1.Widget_ObjectWidget:
+	case *cmsv1.Widget_ListWidget:
+		if protoField.Desc.IsList() {
+			return
+		}
+	default:
+		return
+	}
+	widget := field.GetWidget().ProtoReflect()
+	widgetType := widget.WhichOneof(widget.Descriptor().Oneofs().ByName("widget_type"))
+	log.Fatalf("%s: enum field can't use a %s", protoField.Desc.FullName(), widgetType.Name())
+}
+
+// inferEnumField infers a select widget with an option per enum value.
+// Deprecated values are hidden, and the unspecified value is hidden for required fields.
+// Options of a user-defined select widget take precedence, and the item limits of a user-defined
+// list widget are used as the limits of the multiple select.
+func inferEnumField(field *cmsv1.Field, protoField *protogen.Field) *cmsv1.Field {
+	selectWidget := &cmsv1.SelectWidget{
+		Multiple: protoField.Desc.IsList(),
+	}
+	if protoField.Desc.IsList() {
+		listWidget := inferListWidget(protoField)
+		if widget, ok := field.GetWidget().GetWidgetType().(*cmsv1.Widget_ListWidget); ok {
+			proto.Merge(listWidget, widget.ListWidget)
+		}
+		selectWidget.MultipleMin = listWidget.GetMinItems()
+		selectWidget.MultipleMax = listWidget.GetMaxItems()
+	}
+	for _, value := range protoField.Enum.Values {
+		if inferRequired(protoField) && strings.HasSuffix(string(value.Desc.Name()), "_UNSPECIFIED") {
+			continue
+		}
+		if value.Desc.Options().(*descriptorpb.EnumValueOptions).GetDeprecated() {
+			continue
+		}
+		selectWidget.Options = append(selectWidget.Options, &cmsv1.SelectWidget_Option{
+			Label: inferEnumValueLabel(value),
+			Value: string(value.Desc.Name()),
+		})
+	}
+	if widget, ok := field.GetWidget().GetWidgetType().(*cmsv1.Widget_SelectWidget); ok {
+		if len(widget.SelectWidget.GetOptions()) > 0 {
+			selectWidget.Options = nil
+		}
+		proto.Merge(selectWidget, widget.SelectWidget)
+	}
+	field.Widget.WidgetType = &cmsv1.Widget_SelectWidget{
+		SelectWidget: selectWidget,
+	}
+	return field
+}
+
+// inferEnumValueLabel infers the label of an enum value from its annotation, or the first sentence of
+// its comment, or else its name. The zero value is labeled by its name, since its comment is
+// typically boilerplate such as "Default value. This value is unused."
+func inferEnumValueLabel(value *protogen.EnumValue) string {
+	if enumValueAnnotation := proto.GetExtension(
+		value.Desc.Options(),
+		cmsv1.E_EnumValue,
+	).(*cmsv1.EnumValue); enumValueAnnotation.GetLabel() != "" {
+		return enumValueAnnotation.GetLabel()
+	}
+	comment := strings.TrimSpace(string(value.Comments.Leading))
+	if comment != "" && value.Desc.Number() != 0 {
+		line, _, _ := strings.Cut(comment, "\n")
+		sentence, _, _ := strings.Cut(line, ". ")
+		return strings.TrimSuffix(strings.TrimSpace(sentence), ".")
+	}
+	return strings.ReplaceAll(string(value.Desc.Name()), "_", " ")
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"testing"
+
+	examplev1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/example/v1"
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestInferEnumField_ListWidget(t *testing.T) {
+	gen := newTestPlugin(t, examplev1.File_einride_decap_cms_example_v1_kitchen_sink_proto)
+	message := findTestMessage(t, gen, "einride.decap.cms.example.v1.KitchenSink")
+	protoField := findTestField(t, message, "example_enums")
+	field := &cmsv1.Field{
+		Name: "example_enums",
+		Widget: &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_ListWidget{
+				ListWidget: &cmsv1.ListWidget{MinItems: 1, MaxItems: 2},
+			},
+		},
+	}
+	if !isEnumSelectField(field, protoField) {
+		t.Fatal("expected an enum select field")
+	}
+	checkEnumWidget(field, protoField)
+	actual := genField(inferEnumField(field, protoField))
+	for key, expected := range map[string]any{
+		"widget":   "select",
+		"multiple": true,
+		"min":      int64(1),
+		"max":      int64(2),
+	} {
+		if value, _ := actual.Get(key); value != expected {
+			t.Errorf("%s: expected %v, got %v", key, expected, value)
+		}
+	}
+}
+
+func TestCheckEnumWidget_Error(t *testing.T) {
+	gen := newTestPlugin(t, examplev1.File_einride_decap_cms_example_v1_kitchen_sink_proto)
+	message := findTestMessage(t, gen, "einride.decap.cms.example.v1.KitchenSink")
+	for _, tt := range []struct {
+		name     string
+		field    protoreflect.Name
+		widget   *cmsv1.Widget
+		expected string
+	}{
+		{
+			name:  "number widget",
+			field: "example_enum",
+			widget: &cmsv1.Widget{
+				WidgetType: &cmsv1.Widget_NumberWidget{NumberWidget: &cmsv1.NumberWidget{}},
+			},
+			expected: "KitchenSink.example_enum: enum field can't use a number_widget",
+		},
+		{
+			name:  "boolean widget",
+			field: "example_enums",
+			widget: &cmsv1.Widget{
+				WidgetType: &cmsv1.Widget_BooleanWidget{BooleanWidget: &cmsv1.BooleanWidget{}},
+			},
+			expected: "KitchenSink.example_enums: enum field can't use a boolean_widget",
+		},
+		{
+			name:  "list widget of a singular enum",
+			field: "example_enum",
+			widget: &cmsv1.Widget{
+				WidgetType: &cmsv1.Widget_ListWidget{ListWidget: &cmsv1.ListWidget{}},
+			},
+			expected: "KitchenSink.example_enum: enum field can't use a list_widget",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			protoField := findTestField(t, message, tt.field)
+			expectFatal(t, tt.expected, func() {
+				checkEnumWidget(&cmsv1.Field{Name: string(tt.field), Widget: tt.widget}, protoField)
+			})
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"log"
+
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// collectFilter infers a hidden discriminator field for the filter of a collection, with the filter
+// value as its default, so that new entries are created with a value that matches the filter.
+// Decap CMS compares filter values strictly, so the filter field must be a string or enum field.
+func collectFilter(collection *cmsv1.Collection, message *protogen.Message) {
+	filter := collection.GetFilter()
+	if filter == nil {
+		return
+	}
+	switch {
+	case len(collection.GetFiles()) > 0:
+		log.Fatalf("%s: filter requires a folder collection", message.Desc.FullName())
+	case filter.GetValue() != "" && len(filter.GetValues()) > 0:
+		log.Fatalf("%s: filter must have one of value and values", message.Desc.FullName())
+	case filter.GetValue() == "" && len(filter.GetValues()) == 0:
+		log.Fatalf("%s: filter must have a value or values", message.Desc.FullName())
+	}
+	protoField := message.Desc.Fields().ByName(protoreflect.Name(filter.GetField()))
+	field := findField(collection.GetFields(), filter.GetField())
+	if protoField == nil || field == nil {
+		log.Fatalf("%s: filter field %s not found", message.Desc.FullName(), filter.GetField())
+	}
+	if protoField.IsList() ||
+		protoField.Kind() != protoreflect.StringKind && protoField.Kind() != protoreflect.EnumKind {
+		log.Fatalf("%s: filter field %s must be a string or enum field", message.Desc.FullName(), filter.GetField())
+	}
+	values := filter.GetValues()
+	if filter.GetValue() != "" {
+		values = []string{filter.GetValue()}
+	}
+	if protoField.Kind() == protoreflect.EnumKind {
+		for _, value := range values {
+			if protoField.Enum().Values().ByName(protoreflect.Name(value)) == nil {
+				log.Fatalf("%s: filter value %s is not a value of %s", message.Desc.FullName(), value, protoField.Enum().FullName())
+			}
+		}
+	}
+	defaultValue := values[0]
+	field.Widget.WidgetType = &cmsv1.Widget_HiddenWidget{
+		HiddenWidget: &cmsv1.HiddenWidget{
+			DefaultValue: &cmsv1.HiddenWidget_DefaultString{DefaultString: defaultValue},
+		},
+	}
+}
+
+func genFilter(filter *cmsv1.Collection_Filter) mapping {
+	var result mapping
+	result.Set("field", filter.GetField())
+	if len(filter.GetValues()) > 0 {
+		values := sequence{}
+		for _, value := range filter.GetValues() {
+			values = append(values, value)
+		}
+		result.Set("values", values)
+	} else {
+		result.Set("value", filter.GetValue())
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"gopkg.in/yaml.v3"
+)
+
+// checkFolder fails generation if the file name of an entry in the folder of a collection is not the
+// final ID of the resource name inside it, as created by the inferred slug and nested config, such as
+// an entry whose resource name was edited after it was created.
+// The folder is resolved relative to the content root, and a missing folder is skipped, since Decap
+// CMS creates the folder of a collection with its first entry.
+func checkFolder(collection *cmsv1.Collection, contentRoot string) {
+	if collection.GetFolder() == "" || len(collection.GetFiles()) > 0 {
+		return
+	}
+	extension := fileExtension(collection)
+	frontmatter := isFrontmatterFormat(collection.GetFileFormat())
+	if extension != ".json" && extension != ".yml" && !frontmatter {
+		return
+	}
+	indexFile := collection.GetNested().GetIndexFile()
+	if indexFile == "" {
+		indexFile = "index"
+	}
+	folder := filepath.Join(contentRoot, collection.GetFolder())
+	var mismatches []string
+	err := filepath.WalkDir(folder, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || filepath.Ext(path) != extension {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if frontmatter {
+			data = yamlFrontmatter(data)
+		}
+		var content map[string]any
+		if err := yaml.Unmarshal(data, &content); err != nil {
+			log.Printf("warning: %s: %v", path, err)
+			return nil
+		}
+		name, ok := content["name"].(string)
+		if !ok || name == "" {
+			return nil
+		}
+		fileName := strings.TrimSuffix(filepath.Base(path), extension)
+		if fileName == indexFile {
+			// index files are named by their folder, and singletons of a parent by the folder of the parent
+			fileName = filepath.Base(filepath.Dir(path))
+			name = strings.TrimSuffix(name, "/"+indexFile)
+		}
+		if id := name[strings.LastIndex(name, "/")+1:]; fileName != id {
+			mismatches = append(mismatches, fmt.Sprintf("%s (%s)", path, content["name"]))
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Printf("warning: %s: %v", collection.GetName(), err)
+	}
+	if len(mismatches) > 0 {
+		log.Fatalf(
+			"%s: file names of entries don't match their resource names: %s",
+			collection.GetName(),
+			strings.Join(mismatches, ", "),
+		)
+	}
+}
+
+// yamlFrontmatter returns the YAML frontmatter of a Markdown entry, or nil if it has none.
+func yamlFrontmatter(data []byte) []byte {
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	if string(bytes.TrimSpace(lines[0])) != "---" {
+		return nil
+	}
+	var result []byte
+	for _, line := range lines[1:] {
+		if string(bytes.TrimSpace(line)) == "---" {
+			return result
+		}
+		result = append(result, line...)
+	}
+	return nil
+}
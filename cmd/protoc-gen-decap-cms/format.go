@@ -0,0 +1,119 @@
+package main
+
+import (
+	"log"
+
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// formatNames are the Decap CMS names of the entry file formats.
+var formatNames = map[cmsv1.Collection_Format]string{
+	cmsv1.Collection_YAML:             "yaml",
+	cmsv1.Collection_TOML:             "toml",
+	cmsv1.Collection_JSON:             "json",
+	cmsv1.Collection_FRONTMATTER:      "frontmatter",
+	cmsv1.Collection_YAML_FRONTMATTER: "yaml-frontmatter",
+	cmsv1.Collection_TOML_FRONTMATTER: "toml-frontmatter",
+	cmsv1.Collection_JSON_FRONTMATTER: "json-frontmatter",
+}
+
+// collectFormat resolves the file format of a collection from its deprecated format name.
+// A file format in the collection annotation takes precedence. A frontmatter delimiter is only
+// allowed for frontmatter formats, or without a format.
+func collectFormat(collection *cmsv1.Collection, message *protogen.Message) {
+	//nolint:staticcheck // the deprecated format name is still supported
+	name := collection.GetFormat()
+	if collection.GetFileFormat() == cmsv1.Collection_FORMAT_UNSPECIFIED && name != "" {
+		collection.FileFormat = resolveFormatName(message, name)
+	}
+	if format := collection.GetFileFormat(); len(collection.GetFrontmatterDelimiter()) > 0 &&
+		format != cmsv1.Collection_FORMAT_UNSPECIFIED && !isFrontmatterFormat(format) {
+		log.Fatalf(
+			"%s: frontmatter_delimiter requires a frontmatter format, got %s",
+			message.Desc.FullName(),
+			formatNames[format],
+		)
+	}
+}
+
+// resolveFormatName returns the file format with the Decap CMS name.
+func resolveFormatName(message *protogen.Message, name string) cmsv1.Collection_Format {
+	if name == "yml" {
+		name = "yaml"
+	}
+	for format, formatName := range formatNames {
+		if formatName == name {
+			return format
+		}
+	}
+	log.Fatalf("%s: unsupported format %s", message.Desc.FullName(), name)
+	return cmsv1.Collection_FORMAT_UNSPECIFIED
+}
+
+// collectFormatFields adapts the inferred fields of a collection to its file format.
+// Comments are dropped for formats that don't support them, and a body field is only allowed for
+// frontmatter formats.
+func collectFormatFields(collection *cmsv1.Collection, message *protogen.Message) {
+	var bodyFields int
+	for _, field := range collection.GetFields() {
+		if field.GetBody() {
+			bodyFields++
+		}
+	}
+	switch {
+	case bodyFields > 1:
+		log.Fatalf("%s: at most one field can be the body", message.Desc.FullName())
+	case bodyFields == 1 && !isFrontmatterFormat(collection.GetFileFormat()):
+		log.Fatalf("%s: a body field requires a frontmatter format", message.Desc.FullName())
+	}
+	if supportsComments(collection.GetFileFormat()) {
+		return
+	}
+	clearComments(collection.GetFields())
+	for _, file := range collection.GetFiles() {
+		clearComments(file.GetFields())
+	}
+}
+
+// isFrontmatterFormat reports whether entries of the format are Markdown files with frontmatter.
+func isFrontmatterFormat(format cmsv1.Collection_Format) bool {
+	switch format {
+	case cmsv1.Collection_FRONTMATTER,
+		cmsv1.Collection_YAML_FRONTMATTER,
+		cmsv1.Collection_TOML_FRONTMATTER,
+		cmsv1.Collection_JSON_FRONTMATTER:
+		return true
+	}
+	return false
+}
+
+// supportsComments reports whether Decap CMS writes field comments to entries of the format.
+// Entries of collections without a format are JSON files, see fileExtension.
+func supportsComments(format cmsv1.Collection_Format) bool {
+	switch format {
+	case cmsv1.Collection_YAML,
+		cmsv1.Collection_FRONTMATTER,
+		cmsv1.Collection_YAML_FRONTMATTER:
+		return true
+	}
+	return false
+}
+
+// clearComments clears the comments of fields and of their nested fields.
+func clearComments(fields []*cmsv1.Field) {
+	for _, field := range fields {
+		if field == nil {
+			continue
+		}
+		field.Comment = ""
+		switch widget := field.GetWidget().GetWidgetType().(type) {
+		case *cmsv1.Widget_ObjectWidget:
+			clearComments(widget.ObjectWidget.GetFields())
+		case *cmsv1.Widget_ListWidget:
+			clearComments(widget.ListWidget.GetFields())
+			clearComments(widget.ListWidget.GetTypes())
+			clearComments([]*cmsv1.Field{widget.ListWidget.GetField()})
+		}
+	}
+}
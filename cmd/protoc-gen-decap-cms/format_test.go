@@ -0,0 +1,79 @@
+package main
+
+import (
+	"testing"
+
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+)
+
+func TestCollectFormat(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		collection *cmsv1.Collection
+		expected   cmsv1.Collection_Format
+	}{
+		{
+			name:       "format name",
+			collection: &cmsv1.Collection{Format: "yml"},
+			expected:   cmsv1.Collection_YAML,
+		},
+		{
+			name:       "file format takes precedence",
+			collection: &cmsv1.Collection{Format: "yml", FileFormat: cmsv1.Collection_TOML},
+			expected:   cmsv1.Collection_TOML,
+		},
+		{
+			name: "frontmatter delimiter of a frontmatter format",
+			collection: &cmsv1.Collection{
+				FileFormat:           cmsv1.Collection_YAML_FRONTMATTER,
+				FrontmatterDelimiter: []string{"~~~"},
+			},
+			expected: cmsv1.Collection_YAML_FRONTMATTER,
+		},
+		{
+			name:       "frontmatter delimiter without a format",
+			collection: &cmsv1.Collection{FrontmatterDelimiter: []string{"~~~"}},
+			expected:   cmsv1.Collection_FORMAT_UNSPECIFIED,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			collectFormat(tt.collection, newTestMessage(t, nil))
+			if actual := tt.collection.GetFileFormat(); actual != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCollectFormat_Error(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		collection *cmsv1.Collection
+		expected   string
+	}{
+		{
+			name:       "unsupported format name",
+			collection: &cmsv1.Collection{Format: "xml"},
+			expected:   "test.v1.Test: unsupported format xml",
+		},
+		{
+			name: "frontmatter delimiter of a JSON file format",
+			collection: &cmsv1.Collection{
+				FileFormat:           cmsv1.Collection_JSON,
+				FrontmatterDelimiter: []string{"~~~"},
+			},
+			expected: "test.v1.Test: frontmatter_delimiter requires a frontmatter format, got json",
+		},
+		{
+			name:       "frontmatter delimiter of a YAML format name",
+			collection: &cmsv1.Collection{Format: "yaml", FrontmatterDelimiter: []string{"---", "---"}},
+			expected:   "test.v1.Test: frontmatter_delimiter requires a frontmatter format, got yaml",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			expectFatal(t, tt.expected, func() {
+				collectFormat(tt.collection, newTestMessage(t, nil))
+			})
+		})
+	}
+}
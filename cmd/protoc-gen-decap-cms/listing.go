@@ -0,0 +1,139 @@
+package main
+
+import (
+	"regexp"
+
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+)
+
+// maxViewGroupOptions is the maximum number of values of an enum field inferred as a view group.
+const maxViewGroupOptions = 10
+
+// collectListingControls infers the sortable fields, view filters and view groups of a folder collection,
+// from its fields. Listing controls in the collection annotation take precedence.
+func collectListingControls(collection *cmsv1.Collection) {
+	if len(collection.GetFiles()) > 0 {
+		return
+	}
+	if len(collection.GetSortableFields()) == 0 {
+		collection.SortableFields = inferSortableFields(collection)
+	}
+	if len(collection.GetViewFilters()) == 0 {
+		collection.ViewFilters = inferViewFilters(collection.GetFields())
+	}
+	if len(collection.GetViewGroups()) == 0 {
+		collection.ViewGroups = inferViewGroups(collection.GetFields())
+	}
+}
+
+// inferSortableFields infers the fields of the summary template and date and timestamp fields as sortable.
+func inferSortableFields(collection *cmsv1.Collection) []string {
+	var result []string
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			result = append(result, name)
+		}
+	}
+	for _, match := range summaryFieldRegexp.FindAllStringSubmatch(collection.GetSummary(), -1) {
+		if findField(collection.GetFields(), match[1]) != nil {
+			add(match[1])
+		}
+	}
+	for _, field := range collection.GetFields() {
+		if widget, ok := field.GetWidget().GetWidgetType().(*cmsv1.Widget_DateTimeWidget); ok &&
+			widget.DateTimeWidget.GetDateFormat() != "" {
+			add(field.GetName())
+		}
+	}
+	return result
+}
+
+// inferViewFilters infers a view filter per value of single select fields and per boolean field.
+func inferViewFilters(fields []*cmsv1.Field) []*cmsv1.Collection_ViewFilter {
+	var result []*cmsv1.Collection_ViewFilter
+	for _, field := range fields {
+		switch widget := field.GetWidget().GetWidgetType().(type) {
+		case *cmsv1.Widget_SelectWidget:
+			if widget.SelectWidget.GetMultiple() {
+				continue
+			}
+			for _, option := range widget.SelectWidget.GetOptions() {
+				result = append(result, &cmsv1.Collection_ViewFilter{
+					Label:   field.GetLabel() + ": " + option.GetLabel(),
+					Field:   field.GetName(),
+					Pattern: "^" + regexp.QuoteMeta(option.GetValue()) + "$",
+				})
+			}
+		case *cmsv1.Widget_BooleanWidget:
+			result = append(result, &cmsv1.Collection_ViewFilter{
+				Label:   field.GetLabel(),
+				Field:   field.GetName(),
+				Pattern: "true",
+			})
+		}
+	}
+	return result
+}
+
+// inferViewGroups infers a view group per single select field with few options.
+func inferViewGroups(fields []*cmsv1.Field) []*cmsv1.Collection_ViewGroup {
+	var result []*cmsv1.Collection_ViewGroup
+	for _, field := range fields {
+		widget, ok := field.GetWidget().GetWidgetType().(*cmsv1.Widget_SelectWidget)
+		if !ok || widget.SelectWidget.GetMultiple() || len(widget.SelectWidget.GetOptions()) > maxViewGroupOptions {
+			continue
+		}
+		result = append(result, &cmsv1.Collection_ViewGroup{
+			Label: field.GetLabel(),
+			Field: field.GetName(),
+		})
+	}
+	return result
+}
+
+func findField(fields []*cmsv1.Field, name string) *cmsv1.Field {
+	for _, field := range fields {
+		if field.GetName() == name {
+			return field
+		}
+	}
+	return nil
+}
+
+func genListingControls(collection *cmsv1.Collection) mapping {
+	var result mapping
+	if len(collection.GetSortableFields()) > 0 {
+		sortableFields := sequence{}
+		for _, sortableField := range collection.GetSortableFields() {
+			sortableFields = append(sortableFields, sortableField)
+		}
+		result.Set("sortable_fields", sortableFields)
+	}
+	if len(collection.GetViewFilters()) > 0 {
+		viewFilters := sequence{}
+		for _, viewFilter := range collection.GetViewFilters() {
+			viewFilters = append(viewFilters, mapping{
+				{key: "label", value: viewFilter.GetLabel()},
+				{key: "field", value: viewFilter.GetField()},
+				{key: "pattern", value: viewFilter.GetPattern()},
+			})
+		}
+		result.Set("view_filters", viewFilters)
+	}
+	if len(collection.GetViewGroups()) > 0 {
+		viewGroups := sequence{}
+		for _, viewGroup := range collection.GetViewGroups() {
+			var group mapping
+			group.Set("label", viewGroup.GetLabel())
+			group.Set("field", viewGroup.GetField())
+			if viewGroup.GetPattern() != "" {
+				group.Set("pattern", viewGroup.GetPattern())
+			}
+			viewGroups = append(viewGroups, group)
+		}
+		result.Set("view_groups", viewGroups)
+	}
+	return result
+}
@@ -1,11 +1,15 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
-	"regexp"
+	"math"
+	"path"
+	"slices"
 	"strconv"
 	"strings"
+	"unicode"
 
 	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
 	"google.golang.org/genproto/googleapis/api/annotations"
@@ -15,7 +19,17 @@ import (
 )
 
 func main() {
-	protogen.Options{}.Run(func(gen *protogen.Plugin) error {
+	var flags flag.FlagSet
+	var outputs outputFormats
+	flags.Var(&outputs, "output", "format of the generated config: yaml, json or js (repeatable)")
+	contentRoot := flags.String(
+		"content_root",
+		"",
+		"directory of the collection folders, to check that entry file names match their resource names",
+	)
+	protogen.Options{
+		ParamFunc: flags.Set,
+	}.Run(func(gen *protogen.Plugin) error {
 		for _, file := range gen.Files {
 			if !file.Generate {
 				continue
@@ -24,300 +38,629 @@ func main() {
 			if config == nil {
 				continue
 			}
-			collectMessages(config, file.Desc.Package(), gen.Files)
-			g := &generatedYAMLFile{
-				GeneratedFile: gen.NewGeneratedFile(file.GeneratedFilenamePrefix+".yml", file.GoImportPath),
-			}
-			g.Y("# Generated by protoc-gen-decap-cms. DO NOT EDIT.")
-			g.Y("backend:")
-			g.Up()
-			genBackend(g, config.GetBackend())
-			g.Down()
-			if config.GetLocalBackend() != nil {
-				g.Y()
-				g.Y("local_backend:")
-				g.Up()
-				genLocalBackend(g, config.GetLocalBackend())
-				g.Down()
-			}
-			g.Y()
-			g.Y("slug:")
-			g.Up()
-			genSlug(g, config.GetSlug())
-			g.Down()
-			if config.GetPublishMode() == cmsv1.Config_EDITORIAL_WORKFLOW {
-				g.Y()
-				g.Y("publish_mode: editorial_workflow")
-			}
-			if config.GetMediaFolder() != "" {
-				g.Y()
-				g.Y("media_folder: ", strconv.Quote(config.GetMediaFolder()))
-			}
-			if config.GetLogoUrl() != "" {
-				g.Y()
-				g.Y("logo_url: ", strconv.Quote(config.GetLogoUrl()))
-			}
-			g.Y()
-			g.Y("collections:")
-			for _, collection := range config.GetCollections() {
-				g.Up()
-				genCollection(g, collection)
-				g.Down()
+			collectMessages(config, file.Desc.Package(), gen.Files, *contentRoot)
+			document := genConfig(config)
+			for _, output := range outputs.OrDefault() {
+				extension, content, err := marshalOutput(document, output)
+				if err != nil {
+					return fmt.Errorf("%s: %w", file.Desc.Path(), err)
+				}
+				g := gen.NewGeneratedFile(file.GeneratedFilenamePrefix+extension, file.GoImportPath)
+				if _, err := g.Write(content); err != nil {
+					return err
+				}
 			}
 		}
 		return nil
 	})
 }
 
-func genBackend(g *generatedYAMLFile, backend *cmsv1.Config_Backend) {
+func genConfig(config *cmsv1.Config) mapping {
+	var result mapping
+	result.Set("backend", genBackend(config.GetBackend()))
+	if config.GetLocalBackend() != nil {
+		result.Set("local_backend", genLocalBackend(config.GetLocalBackend()))
+	}
+	result.Set("slug", genSlug(config.GetSlug()))
+	if config.GetPublishMode() == cmsv1.Config_EDITORIAL_WORKFLOW {
+		result.Set("publish_mode", "editorial_workflow")
+	}
+	if config.GetMediaFolder() != "" {
+		result.Set("media_folder", config.GetMediaFolder())
+	}
+	if config.GetLogoUrl() != "" {
+		result.Set("logo_url", config.GetLogoUrl())
+	}
+	collections := sequence{}
+	for _, collection := range config.GetCollections() {
+		collections = append(collections, genCollection(collection))
+	}
+	result.Set("collections", collections)
+	return result
+}
+
+func genBackend(backend *cmsv1.Config_Backend) mapping {
+	var result mapping
 	if backend.GetName() != "" {
-		g.Y("name: ", strconv.Quote(backend.GetName()))
+		result.Set("name", backend.GetName())
 	}
 	if backend.GetRepo() != "" {
-		g.Y("repo: ", strconv.Quote(backend.GetRepo()))
+		result.Set("repo", backend.GetRepo())
 	}
 	if backend.GetBranch() != "" {
-		g.Y("branch: ", strconv.Quote(backend.GetBranch()))
+		result.Set("branch", backend.GetBranch())
 	}
 	if backend.GetSquashMerges() {
-		g.Y("squash_merges: true")
+		result.Set("squash_merges", true)
 	}
 	if backend.GetBaseUrl() != "" {
-		g.Y("base_url: ", strconv.Quote(backend.GetBaseUrl()))
+		result.Set("base_url", backend.GetBaseUrl())
 	}
 	if backend.GetSiteDomain() != "" {
-		g.Y("site_domain: ", strconv.Quote(backend.GetSiteDomain()))
+		result.Set("site_domain", backend.GetSiteDomain())
 	}
 	if backend.GetCommitMessages() != nil {
-		g.Y("commit_messages:")
-		g.Up()
+		var commitMessages mapping
 		if backend.GetCommitMessages().GetCreate() != "" {
-			g.Y("create: ", strconv.Quote(backend.GetCommitMessages().GetCreate()))
+			commitMessages.Set("create", backend.GetCommitMessages().GetCreate())
 		}
 		if backend.GetCommitMessages().GetUpdate() != "" {
-			g.Y("update: ", strconv.Quote(backend.GetCommitMessages().GetUpdate()))
+			commitMessages.Set("update", backend.GetCommitMessages().GetUpdate())
 		}
 		if backend.GetCommitMessages().GetDelete() != "" {
-			g.Y("delete: ", strconv.Quote(backend.GetCommitMessages().GetDelete()))
+			commitMessages.Set("delete", backend.GetCommitMessages().GetDelete())
 		}
 		if backend.GetCommitMessages().GetUploadMedia() != "" {
-			g.Y("uploadMedia: ", strconv.Quote(backend.GetCommitMessages().GetUploadMedia()))
+			commitMessages.Set("uploadMedia", backend.GetCommitMessages().GetUploadMedia())
 		}
 		if backend.GetCommitMessages().GetDeleteMedia() != "" {
-			g.Y("deleteMedia: ", strconv.Quote(backend.GetCommitMessages().GetDeleteMedia()))
+			commitMessages.Set("deleteMedia", backend.GetCommitMessages().GetDeleteMedia())
 		}
-		g.Down()
+		result.Set("commit_messages", commitMessages)
 	}
+	return result
 }
 
-func genLocalBackend(g *generatedYAMLFile, localBackend *cmsv1.Config_LocalBackend) {
+func genLocalBackend(localBackend *cmsv1.Config_LocalBackend) mapping {
+	var result mapping
 	if localBackend.GetUrl() != "" {
-		g.Y("url: ", strconv.Quote(localBackend.GetUrl()))
+		result.Set("url", localBackend.GetUrl())
 	}
+	return result
 }
 
-func genSlug(g *generatedYAMLFile, slug *cmsv1.Config_Slug) {
+func genSlug(slug *cmsv1.Config_Slug) mapping {
+	var result mapping
 	switch slug.GetEncoding() {
 	case cmsv1.Config_Slug_UNICODE:
-		g.Y("encoding: ", strconv.Quote("unicode"))
+		result.Set("encoding", "unicode")
 	case cmsv1.Config_Slug_ASCII:
-		g.Y("encoding: ", strconv.Quote("ascii"))
+		result.Set("encoding", "ascii")
 	default:
-		g.Y("encoding: ", strconv.Quote("unicode"))
+		result.Set("encoding", "unicode")
 	}
-	g.Y("clean_accents: ", strconv.FormatBool(slug.GetCleanAccents()))
+	result.Set("clean_accents", slug.GetCleanAccents())
 	if slug.GetSanitizeReplacement() != "" {
-		g.Y("sanitize_replacement: ", strconv.Quote(slug.GetSanitizeReplacement()))
+		result.Set("sanitize_replacement", slug.GetSanitizeReplacement())
 	}
+	return result
 }
 
-func genCollection(g *generatedYAMLFile, collection *cmsv1.Collection) {
-	g.Y()
-	g.Y("- name: ", strconv.Quote(collection.GetName()))
-	g.Up()
-	defer g.Down()
+func genCollection(collection *cmsv1.Collection) mapping {
+	var result mapping
+	result.Set("name", collection.GetName())
 	if collection.GetLabel() != "" {
-		g.Y("label: ", strconv.Quote(collection.GetLabel()))
+		result.Set("label", collection.GetLabel())
 	}
 	if collection.GetLabelSingular() != "" {
-		g.Y("label_singular: ", strconv.Quote(collection.GetLabelSingular()))
+		result.Set("label_singular", collection.GetLabelSingular())
+	}
+	if len(collection.GetFiles()) > 0 {
+		result.Append(genFileCollection(collection))
+		return result
 	}
 	if collection.GetFolder() != "" {
-		g.Y("folder: ", strconv.Quote(collection.GetFolder()))
+		result.Set("folder", collection.GetFolder())
+	}
+	result.Set("create", collection.GetCreate())
+	if collection.Delete != nil {
+		result.Set("delete", collection.GetDelete())
 	}
-	g.Y("create: ", strconv.FormatBool(collection.GetCreate()))
 	if collection.GetIdentifierField() != "" {
-		g.Y("identifier_field: ", strconv.Quote(collection.GetIdentifierField()))
+		result.Set("identifier_field", collection.GetIdentifierField())
+	}
+	if format, ok := formatNames[collection.GetFileFormat()]; ok {
+		result.Set("format", format)
+	}
+	if collection.GetExtension() != "" {
+		result.Set("extension", collection.GetExtension())
+	}
+	if delimiter := genFrontmatterDelimiter(collection.GetFrontmatterDelimiter()); delimiter != nil {
+		result.Set("frontmatter_delimiter", delimiter)
+	}
+	if collection.GetSlug() != "" {
+		result.Set("slug", collection.GetSlug())
+	}
+	if collection.GetPath() != "" {
+		result.Set("path", collection.GetPath())
 	}
-	if collection.GetFormat() != "" {
-		g.Y("format: ", strconv.Quote(collection.GetFormat()))
+	if collection.GetFilter() != nil {
+		result.Set("filter", genFilter(collection.GetFilter()))
+	}
+	result.Append(genCollectionOptions(collection))
+	if collection.GetPreviewPath() != "" {
+		result.Set("preview_path", collection.GetPreviewPath())
+	}
+	if collection.GetPreviewPathDateField() != "" {
+		result.Set("preview_path_date_field", collection.GetPreviewPathDateField())
 	}
 	if collection.GetDescription() != "" {
-		g.Y("description: ", strconv.Quote(collection.GetDescription()))
+		result.Set("description", collection.GetDescription())
 	}
 	if collection.GetSummary() != "" {
-		g.Y("summary: ", strconv.Quote(collection.GetSummary()))
+		result.Set("summary", collection.GetSummary())
+	}
+	result.Append(genListingControls(collection))
+	result.Set("editor", mapping{{key: "preview", value: collection.GetEditor().GetPreview()}})
+	if collection.GetNested() != nil {
+		result.Append(genNested(collection.GetNested()))
+	}
+	result.Set("fields", genFields(collection.GetFields()))
+	return result
+}
+
+func genFileCollection(collection *cmsv1.Collection) mapping {
+	var result mapping
+	if format, ok := formatNames[collection.GetFileFormat()]; ok {
+		result.Set("format", format)
+	}
+	if delimiter := genFrontmatterDelimiter(collection.GetFrontmatterDelimiter()); delimiter != nil {
+		result.Set("frontmatter_delimiter", delimiter)
 	}
-	g.Y("editor:")
-	g.Up()
-	g.Y("preview: ", strconv.FormatBool(collection.GetEditor().GetPreview()))
-	g.Down()
-	g.Y("fields:")
-	for _, field := range collection.GetFields() {
-		g.Up()
-		genField(g, field)
-		g.Down()
+	result.Append(genCollectionOptions(collection))
+	if collection.GetDescription() != "" {
+		result.Set("description", collection.GetDescription())
+	}
+	result.Set("editor", mapping{{key: "preview", value: collection.GetEditor().GetPreview()}})
+	files := sequence{}
+	for _, file := range collection.GetFiles() {
+		files = append(files, genFile(collection, file))
+	}
+	result.Set("files", files)
+	return result
+}
+
+// genCollectionOptions generates the options shared by folder and file collections.
+func genCollectionOptions(collection *cmsv1.Collection) mapping {
+	var result mapping
+	if collection.Publish != nil {
+		result.Set("publish", collection.GetPublish())
+	}
+	if collection.GetHide() {
+		result.Set("hide", true)
+	}
+	if collection.GetMediaFolder() != "" {
+		result.Set("media_folder", collection.GetMediaFolder())
+	}
+	if collection.GetPublicFolder() != "" {
+		result.Set("public_folder", collection.GetPublicFolder())
+	}
+	return result
+}
+
+// genFrontmatterDelimiter generates a frontmatter delimiter, as a string for a single delimiter
+// and as a pair of strings for distinct opening and closing delimiters.
+func genFrontmatterDelimiter(delimiters []string) any {
+	switch len(delimiters) {
+	case 0:
+		return nil
+	case 1:
+		return delimiters[0]
+	case 2:
+		return sequence{delimiters[0], delimiters[1]}
+	default:
+		log.Fatalf("frontmatter_delimiter: expected one or two delimiters, got %d", len(delimiters))
+		return nil
+	}
+}
+
+func genFile(collection *cmsv1.Collection, file *cmsv1.Collection_File) mapping {
+	var result mapping
+	result.Set("name", file.GetName())
+	if file.GetLabel() != "" {
+		result.Set("label", file.GetLabel())
+	}
+	result.Set("file", file.GetFile())
+	if file.GetDescription() != "" {
+		result.Set("description", file.GetDescription())
+	}
+	fields := file.GetFields()
+	if len(fields) == 0 {
+		fields = collection.GetFields()
+	}
+	result.Set("fields", genFields(fields))
+	return result
+}
+
+func genNested(nested *cmsv1.Collection_Nested) mapping {
+	var nestedOptions mapping
+	nestedOptions.Set("depth", int64(nested.GetDepth()))
+	if nested.GetSummary() != "" {
+		nestedOptions.Set("summary", nested.GetSummary())
+	}
+	nestedOptions.Set("subfolders", nested.GetSubfolders())
+	var path mapping
+	path.Set("widget", "string")
+	if nested.GetPathLabel() != "" {
+		path.Set("label", nested.GetPathLabel())
+	}
+	if nested.GetIndexFile() != "" {
+		path.Set("index_file", nested.GetIndexFile())
+	}
+	if nested.GetPathPattern() != nil {
+		path.Set("pattern", genPattern(nested.GetPathPattern()))
+	}
+	var result mapping
+	result.Set("nested", nestedOptions)
+	result.Set("meta", mapping{{key: "path", value: path}})
+	return result
+}
+
+func genFields(fields []*cmsv1.Field) sequence {
+	result := sequence{}
+	for _, field := range fields {
+		result = append(result, genField(field))
 	}
+	return result
+}
+
+// fieldKeys are the keys of a generated field that are not options of its widget.
+var fieldKeys = []string{"name", "label", "comment", "required", "hint", "pattern", "widget"}
+
+func genField(field *cmsv1.Field) mapping {
+	var result mapping
+	result.Set("name", field.GetName())
+	result.Append(genFieldOptions(field))
+	return result
 }
 
-func genField(g *generatedYAMLFile, field *cmsv1.Field) {
-	g.Y()
-	g.Y("- name: ", strconv.Quote(field.GetName()))
-	g.Up()
-	defer g.Down()
+func genPattern(pattern *cmsv1.Widget_Pattern) sequence {
+	return sequence{pattern.GetRegexp(), pattern.GetErrorMessage()}
+}
+
+func genFieldOptions(field *cmsv1.Field) mapping {
+	var result mapping
 	if field.GetLabel() != "" {
-		g.Y("label: ", strconv.Quote(field.GetLabel()))
+		result.Set("label", field.GetLabel())
 	}
 	if field.GetComment() != "" {
-		g.Y("comment: ", strconv.Quote(field.GetComment()))
+		result.Set("comment", field.GetComment())
 	}
 	// Number widget required is handled in switch case
 	if _, isNumberWidget := field.GetWidget().GetWidgetType().(*cmsv1.Widget_NumberWidget); !isNumberWidget {
-		g.Y("required: ", strconv.FormatBool(field.GetWidget().GetRequiredValue()))
+		result.Set("required", field.GetWidget().GetRequiredValue())
 	}
 	if field.GetWidget().GetHint() != "" {
-		g.Y("hint: ", strconv.Quote(strings.TrimSpace(field.GetWidget().GetHint())))
+		result.Set("hint", strings.TrimSpace(field.GetWidget().GetHint()))
 	}
 	if field.GetWidget().GetPattern() != nil {
-		g.Y("pattern:")
-		g.Up()
-		g.Y("- ", strconv.Quote(field.GetWidget().GetPattern().GetRegexp()))
-		g.Y("- ", strconv.Quote(field.GetWidget().GetPattern().GetErrorMessage()))
-		g.Down()
+		result.Set("pattern", genPattern(field.GetWidget().GetPattern()))
 	}
-	switch widget := field.GetWidget().GetWidgetType().(type) {
+	result.Append(genWidgetOptions(field, field.GetWidget().GetWidgetType()))
+	return result
+}
+
+// genWidgetOptions generates the options of the widget type of a field, which is any member of the
+// widget_type oneof, and fails on members without options, such as new members of the oneof.
+func genWidgetOptions(field *cmsv1.Field, widgetType any) mapping {
+	var result mapping
+	switch widget := widgetType.(type) {
 	case *cmsv1.Widget_StringWidget:
-		g.Y("widget: ", strconv.Quote("string"))
-		g.Y("default: ", strconv.Quote(widget.StringWidget.GetDefaultValue()))
+		result.Set("widget", "string")
+		result.Set("default", widget.StringWidget.GetDefaultValue())
 	case *cmsv1.Widget_TextWidget:
-		g.Y("widget: ", strconv.Quote("text"))
-		g.Y("default: ", strconv.Quote(widget.TextWidget.GetDefaultValue()))
+		result.Set("widget", "text")
+		result.Set("default", widget.TextWidget.GetDefaultValue())
 	case *cmsv1.Widget_BooleanWidget:
-		g.Y("widget: ", strconv.Quote("boolean"))
+		result.Set("widget", "boolean")
+		if widget.BooleanWidget.DefaultValue != nil {
+			result.Set("default", widget.BooleanWidget.GetDefaultValue())
+		}
 	case *cmsv1.Widget_SelectWidget:
-		g.Y("widget: ", strconv.Quote("select"))
-		if len(widget.SelectWidget.GetDefaultValue()) == 1 {
-			g.Y("default: ", strconv.Quote(widget.SelectWidget.GetDefaultValue()[0]))
+		result.Set("widget", "select")
+		if widget.SelectWidget.GetMultiple() && len(widget.SelectWidget.GetDefaultValue()) > 0 {
+			defaultValues := sequence{}
+			for _, defaultValue := range widget.SelectWidget.GetDefaultValue() {
+				defaultValues = append(defaultValues, defaultValue)
+			}
+			result.Set("default", defaultValues)
+		} else if len(widget.SelectWidget.GetDefaultValue()) == 1 {
+			result.Set("default", widget.SelectWidget.GetDefaultValue()[0])
+		}
+		result.Set("multiple", widget.SelectWidget.GetMultiple())
+		if widget.SelectWidget.GetMultiple() && widget.SelectWidget.GetMultipleMin() > 0 {
+			result.Set("min", widget.SelectWidget.GetMultipleMin())
 		}
-		g.Y("multiple: ", widget.SelectWidget.GetMultiple())
-		g.Y("options:")
-		g.Up()
+		if widget.SelectWidget.GetMultiple() && widget.SelectWidget.GetMultipleMax() > 0 {
+			result.Set("max", widget.SelectWidget.GetMultipleMax())
+		}
+		options := sequence{}
 		for _, option := range widget.SelectWidget.GetOptions() {
-			g.Y("- label: ", strconv.Quote(option.GetLabel()))
-			g.Up()
-			g.Y("value: ", strconv.Quote(option.GetValue()))
-			g.Down()
+			options = append(options, mapping{
+				{key: "label", value: option.GetLabel()},
+				{key: "value", value: option.GetValue()},
+			})
+		}
+		result.Set("options", options)
+	case *cmsv1.Widget_CodeWidget:
+		result.Set("widget", "code")
+		if widget.CodeWidget.GetDefaultLanguage() != "" {
+			result.Set("default_language", widget.CodeWidget.GetDefaultLanguage())
+		}
+		result.Set("allow_language_selection", widget.CodeWidget.GetAllowLanguageSelection())
+		if widget.CodeWidget.GetOutputCodeOnly() {
+			result.Set("output_code_only", true)
+		}
+		if widget.CodeWidget.GetKeys() != nil {
+			var keys mapping
+			if widget.CodeWidget.GetKeys().GetCode() != "" {
+				keys.Set("code", widget.CodeWidget.GetKeys().GetCode())
+			}
+			if widget.CodeWidget.GetKeys().GetLang() != "" {
+				keys.Set("lang", widget.CodeWidget.GetKeys().GetLang())
+			}
+			result.Set("keys", keys)
+		}
+	case *cmsv1.Widget_ColorWidget:
+		result.Set("widget", "color")
+		if widget.ColorWidget.GetDefaultValue() != "" {
+			result.Set("default", widget.ColorWidget.GetDefaultValue())
+		}
+		result.Set("allowInput", widget.ColorWidget.GetAllowInput())
+		result.Set("enableAlpha", widget.ColorWidget.GetEnableAlpha())
+	case *cmsv1.Widget_MapWidget:
+		result.Set("widget", "map")
+		if widget.MapWidget.GetDecimals() != 0 {
+			result.Set("decimals", widget.MapWidget.GetDecimals())
+		}
+		if widget.MapWidget.GetDefaultValue() != "" {
+			result.Set("default", widget.MapWidget.GetDefaultValue())
+		}
+		switch widget.MapWidget.GetType() {
+		case cmsv1.MapWidget_POINT:
+			result.Set("type", "Point")
+		case cmsv1.MapWidget_LINE_STRING:
+			result.Set("type", "LineString")
+		case cmsv1.MapWidget_POLYGON:
+			result.Set("type", "Polygon")
 		}
-		g.Down()
 	case *cmsv1.Widget_DateTimeWidget:
-		g.Y("widget: ", strconv.Quote("datetime"))
-		if widget.DateTimeWidget.GetDateFormat() != "" {
-			g.Y("date_format: ", strconv.Quote(widget.DateTimeWidget.GetDateFormat()))
+		result.Set("widget", "datetime")
+		if widget.DateTimeWidget.DefaultValue != nil {
+			result.Set("default", widget.DateTimeWidget.GetDefaultValue())
+		}
+		if widget.DateTimeWidget.GetDisableDateFormat() {
+			result.Set("date_format", false)
+		} else if widget.DateTimeWidget.GetDateFormat() != "" {
+			result.Set("date_format", widget.DateTimeWidget.GetDateFormat())
 		}
-		if widget.DateTimeWidget.GetTimeFormat() != "" {
-			g.Y("time_format: ", strconv.Quote(widget.DateTimeWidget.GetTimeFormat()))
+		if widget.DateTimeWidget.GetDisableTimeFormat() {
+			result.Set("time_format", false)
+		} else if widget.DateTimeWidget.GetTimeFormat() != "" {
+			result.Set("time_format", widget.DateTimeWidget.GetTimeFormat())
+		}
+		if widget.DateTimeWidget.GetFormat() != "" {
+			result.Set("format", widget.DateTimeWidget.GetFormat())
+		}
+		if widget.DateTimeWidget.GetPickerUtc() {
+			result.Set("picker_utc", true)
 		}
 	case *cmsv1.Widget_ObjectWidget:
-		g.Y("widget: ", strconv.Quote("object"))
-		g.Y("collapsed: ", strconv.FormatBool(widget.ObjectWidget.GetCollapsed()))
+		result.Set("widget", "object")
+		result.Set("collapsed", widget.ObjectWidget.GetCollapsed())
 		if widget.ObjectWidget.GetSummary() != "" {
-			g.Y("summary: ", strconv.Quote(widget.ObjectWidget.GetSummary()))
+			result.Set("summary", widget.ObjectWidget.GetSummary())
 		}
-		g.Y("fields:")
-		g.Up()
-		for _, objectField := range widget.ObjectWidget.GetFields() {
-			genField(g, objectField)
-		}
-		g.Down()
+		result.Set("fields", genFields(widget.ObjectWidget.GetFields()))
 	case *cmsv1.Widget_ListWidget:
-		g.Y("widget: ", strconv.Quote("list"))
-		g.Y("collapsed: ", strconv.FormatBool(widget.ListWidget.GetCollapsed()))
-		g.Y("minimize_collapsed: ", strconv.FormatBool(widget.ListWidget.GetMinimizeCollapsed()))
+		result.Set("widget", "list")
+		result.Set("collapsed", widget.ListWidget.GetCollapsed())
+		result.Set("minimize_collapsed", widget.ListWidget.GetMinimizeCollapsed())
 		if widget.ListWidget.GetSummary() != "" {
-			g.Y("summary: ", strconv.Quote(widget.ListWidget.GetSummary()))
+			result.Set("summary", widget.ListWidget.GetSummary())
+		}
+		if widget.ListWidget.AllowAdd != nil {
+			result.Set("allow_add", widget.ListWidget.GetAllowAdd())
+		}
+		if widget.ListWidget.GetAddToTop() {
+			result.Set("add_to_top", widget.ListWidget.GetAddToTop())
+		}
+		if widget.ListWidget.GetLabelSingular() != "" {
+			result.Set("label_singular", widget.ListWidget.GetLabelSingular())
+		}
+		if widget.ListWidget.GetMinItems() > 0 {
+			result.Set("min", widget.ListWidget.GetMinItems())
+		}
+		if widget.ListWidget.GetMaxItems() > 0 {
+			result.Set("max", widget.ListWidget.GetMaxItems())
 		}
-		if len(widget.ListWidget.GetFields()) > 0 {
-			g.Y("fields:")
-			g.Up()
-			for _, objectField := range widget.ListWidget.GetFields() {
-				genField(g, objectField)
+		if len(widget.ListWidget.GetTypes()) > 0 {
+			if widget.ListWidget.GetTypeKey() != "" {
+				result.Set("typeKey", widget.ListWidget.GetTypeKey())
 			}
-			g.Down()
+			result.Set("types", genFields(widget.ListWidget.GetTypes()))
+		} else if len(widget.ListWidget.GetFields()) > 0 {
+			result.Set("fields", genFields(widget.ListWidget.GetFields()))
+		} else if widget.ListWidget.GetField() != nil {
+			result.Set("field", genField(widget.ListWidget.GetField()))
 		}
 	case *cmsv1.Widget_NumberWidget:
-		g.Y("widget: ", strconv.Quote("number"))
-		g.Y("value_type: ", strconv.Quote(strings.ToLower(widget.NumberWidget.GetValueType().String())))
-		g.Y("required: ", true) // required since Decap uses empty string for no value instead of 0
-		if !field.GetWidget().GetRequiredValue() {
-			g.Y("default: ", widget.NumberWidget.GetDefaultValue())
+		result.Set("widget", "number")
+		result.Set("value_type", strings.ToLower(widget.NumberWidget.GetValueType().String()))
+		if widget.NumberWidget.GetAllowEmpty() {
+			result.Set("required", field.GetWidget().GetRequiredValue())
+		} else {
+			result.Set("required", true) // required since Decap uses empty string for no value instead of 0
+		}
+		if !field.GetWidget().GetRequiredValue() && !widget.NumberWidget.GetAllowEmpty() {
+			if widget.NumberWidget.GetValueType() == cmsv1.NumberWidget_STRING {
+				result.Set("default", formatNumber(widget.NumberWidget.GetDefaultValue()))
+			} else {
+				result.Set("default", widget.NumberWidget.GetDefaultValue())
+			}
+		}
+		if widget.NumberWidget.MinValue != nil {
+			result.Set("min", widget.NumberWidget.GetMinValue())
+		}
+		if widget.NumberWidget.MaxValue != nil {
+			result.Set("max", widget.NumberWidget.GetMaxValue())
+		}
+		if widget.NumberWidget.GetStep() != 0 {
+			result.Set("step", widget.NumberWidget.GetStep())
 		}
 	case *cmsv1.Widget_RelationWidget:
-		g.Y("widget: ", strconv.Quote("relation"))
-		g.Y("collection: ", strconv.Quote(widget.RelationWidget.GetCollection()))
-		g.Y("value_field: ", strconv.Quote(widget.RelationWidget.GetValueField()))
-		g.Y("search_fields:")
-		g.Up()
+		result.Set("widget", "relation")
+		result.Set("collection", widget.RelationWidget.GetCollection())
+		result.Set("value_field", widget.RelationWidget.GetValueField())
+		searchFields := sequence{}
 		for _, searchField := range widget.RelationWidget.GetSearchFields() {
-			g.Y("- ", strconv.Quote(searchField))
+			searchFields = append(searchFields, searchField)
 		}
-		g.Down()
+		result.Set("search_fields", searchFields)
 		if len(widget.RelationWidget.GetDisplayFields()) > 0 {
-			g.Y("display_fields:")
-			g.Up()
+			displayFields := sequence{}
 			for _, displayField := range widget.RelationWidget.GetDisplayFields() {
-				g.Y("- ", strconv.Quote(displayField))
+				displayFields = append(displayFields, displayField)
 			}
-			g.Down()
+			result.Set("display_fields", displayFields)
 		}
-		g.Y("multiple: ", strconv.FormatBool(widget.RelationWidget.GetMultiple()))
+		result.Set("multiple", widget.RelationWidget.GetMultiple())
 		if len(widget.RelationWidget.GetFilters()) > 0 {
-			g.Y("filters:")
-			g.Up()
+			filters := sequence{}
 			for _, filter := range widget.RelationWidget.GetFilters() {
-				g.Y("- field: ", strconv.Quote(filter.GetField()))
-				g.Up()
-				g.Y("values:")
-				g.Up()
+				values := sequence{}
 				for _, value := range filter.GetValues() {
-					g.Y("- ", strconv.Quote(value))
+					values = append(values, value)
 				}
-				g.Down()
-				g.Down()
+				filters = append(filters, mapping{
+					{key: "field", value: filter.GetField()},
+					{key: "values", value: values},
+				})
 			}
-			g.Down()
+			result.Set("filters", filters)
+		}
+	case *cmsv1.Widget_FileWidget:
+		result.Set("widget", "file")
+		if widget.FileWidget.GetDefaultValue() != "" {
+			result.Set("default", widget.FileWidget.GetDefaultValue())
+		}
+		result.Append(genMediaOptions(
+			widget.FileWidget.GetMediaLibrary(),
+			widget.FileWidget.GetMediaFolder(),
+			widget.FileWidget.GetPublicFolder(),
+		))
+	case *cmsv1.Widget_ImageWidget:
+		result.Set("widget", "image")
+		if widget.ImageWidget.GetDefaultValue() != "" {
+			result.Set("default", widget.ImageWidget.GetDefaultValue())
+		}
+		result.Append(genMediaOptions(
+			widget.ImageWidget.GetMediaLibrary(),
+			widget.ImageWidget.GetMediaFolder(),
+			widget.ImageWidget.GetPublicFolder(),
+		))
+	case *cmsv1.Widget_HiddenWidget:
+		result.Set("widget", "hidden")
+		switch defaultValue := widget.HiddenWidget.GetDefaultValue().(type) {
+		case *cmsv1.HiddenWidget_DefaultBool:
+			result.Set("default", defaultValue.DefaultBool)
+		case *cmsv1.HiddenWidget_DefaultString:
+			result.Set("default", defaultValue.DefaultString)
+		case *cmsv1.HiddenWidget_DefaultDouble:
+			result.Set("default", defaultValue.DefaultDouble)
+		case *cmsv1.HiddenWidget_DefaultInt64:
+			result.Set("default", defaultValue.DefaultInt64)
 		}
+	case *cmsv1.Widget_MarkdownWidget:
+		result.Set("widget", "markdown")
+		if widget.MarkdownWidget.GetDefaultValue() != "" {
+			result.Set("default", widget.MarkdownWidget.GetDefaultValue())
+		}
+		result.Set("minimal", widget.MarkdownWidget.GetMinimal())
 	case *cmsv1.Widget_CustomWidget:
-		g.Y("widget: ", strconv.Quote(widget.CustomWidget.GetWidget()))
-		for _, option := range widget.CustomWidget.GetOptions() {
-			g.Y(option)
+		result.Set("widget", widget.CustomWidget.GetWidget())
+		rawOptions, err := parseYAMLMapping(strings.Join(widget.CustomWidget.GetOptions(), "\n"))
+		if err != nil {
+			log.Fatalf("%s: invalid custom widget options: %v", field.GetName(), err)
+		}
+		appendCustomWidgetOptions(&result, field, rawOptions)
+		if widget.CustomWidget.GetStructuredOptions() != nil {
+			structuredOptions := documentValue(widget.CustomWidget.GetStructuredOptions().AsMap()).(mapping)
+			appendCustomWidgetOptions(&result, field, structuredOptions)
+		}
+	case nil:
+	default:
+		log.Fatalf("%s: unsupported widget type %T", field.GetName(), widget)
+	}
+	return result
+}
+
+// appendCustomWidgetOptions appends options of a custom widget to its generated options, and fails on
+// options that are already set, such as the keys of the field.
+func appendCustomWidgetOptions(result *mapping, field *cmsv1.Field, options mapping) {
+	for _, option := range options {
+		if _, ok := result.Get(option.key); ok || slices.Contains(fieldKeys, option.key) {
+			log.Fatalf("%s: custom widget option %s is already set", field.GetName(), option.key)
 		}
+		result.Set(option.key, option.value)
 	}
 }
 
-func collectMessages(config *cmsv1.Config, pkg protoreflect.FullName, files []*protogen.File) {
+func genMediaOptions(mediaLibrary *cmsv1.MediaLibrary, mediaFolder, publicFolder string) mapping {
+	var result mapping
+	if mediaLibrary != nil {
+		var mediaLibraryOptions mapping
+		if mediaLibrary.AllowMultiple != nil {
+			mediaLibraryOptions.Set("allow_multiple", mediaLibrary.GetAllowMultiple())
+		}
+		if mediaLibrary.ChooseUrl != nil {
+			mediaLibraryOptions.Set("choose_url", mediaLibrary.GetChooseUrl())
+		}
+		if mediaLibrary.GetConfig() != nil {
+			mediaLibraryOptions.Set("config", documentValue(mediaLibrary.GetConfig().AsMap()))
+		}
+		result.Set("media_library", mediaLibraryOptions)
+	}
+	if mediaFolder != "" {
+		result.Set("media_folder", mediaFolder)
+	}
+	if publicFolder != "" {
+		result.Set("public_folder", publicFolder)
+	}
+	return result
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// collectMessages collects the collections of the messages of a package.
+// The entries of each collection are checked when a content root is provided.
+func collectMessages(config *cmsv1.Config, pkg protoreflect.FullName, files []*protogen.File, contentRoot string) {
+	resources := indexResources(pkg, files)
 	for _, file := range files {
 		if file.Desc.Package() != pkg {
 			continue
 		}
 		for _, message := range file.Messages {
-			collection := proto.GetExtension(
+			annotation := proto.GetExtension(
 				message.Desc.Options(),
 				cmsv1.E_Collection,
 			).(*cmsv1.Collection)
-			if collection == nil {
+			if annotation == nil {
 				continue
 			}
-			collection = proto.Clone(collection).(*cmsv1.Collection)
+			collection := proto.Clone(annotation).(*cmsv1.Collection)
 			if collection.GetDescription() == "" {
 				collection.Description = strings.TrimSpace(string(message.Comments.Leading))
 			}
@@ -327,18 +670,133 @@ func collectMessages(config *cmsv1.Config, pkg protoreflect.FullName, files []*p
 				}
 				collection.Description += fmt.Sprintf("[%s]", collection.GetOwner().GetDisplayName())
 			}
-			collectFields(collection, message)
+			collectFormat(collection, message)
+			collectNested(collection, message)
+			collectFiles(collection, message)
+			collectFields(collection, message, resources)
+			collectSlug(collection, message)
+			collectFormatFields(collection, message)
+			collectFilter(collection, message)
+			collectListingControls(collection)
+			if contentRoot != "" && annotation.GetSlug() == "" && annotation.GetPath() == "" {
+				checkFolder(collection, contentRoot)
+			}
 			config.Collections = append(config.Collections, collection)
 		}
 	}
 }
 
-func collectFields(collection *cmsv1.Collection, message *protogen.Message) {
+// collectNested infers the nested collection config of hierarchical resources.
+// A nested collection config in the collection annotation takes precedence, or disables nesting.
+func collectNested(collection *cmsv1.Collection, message *protogen.Message) {
+	if collection.GetNested().GetDisabled() {
+		collection.Nested = nil
+		return
+	}
+	resource := proto.GetExtension(
+		message.Desc.Options(),
+		annotations.E_Resource,
+	).(*annotations.ResourceDescriptor)
+	if len(resource.GetPattern()) == 0 {
+		return
+	}
+	pattern, err := parseResourceNamePattern(resource.GetPattern()[0])
+	if err != nil {
+		log.Fatalf("%s: %v", message.Desc.FullName(), err)
+	}
+	if !pattern.IsSingleton() && !pattern.IsChildSingleton() && !pattern[len(pattern)-1].isVariable() {
+		log.Fatalf(
+			"%s: unsupported resource name pattern %s: singletons of a parent must end with a single literal segment",
+			message.Desc.FullName(),
+			resource.GetPattern()[0],
+		)
+	}
+	nested, ok := inferNested(pattern)
+	if !ok {
+		return
+	}
+	if collection.GetNested() != nil {
+		proto.Merge(nested, collection.GetNested())
+	}
+	collection.Nested = nested
+}
+
+// collectFiles infers a file collection of a single file for singleton resources.
+// Files in the collection annotation take precedence.
+func collectFiles(collection *cmsv1.Collection, message *protogen.Message) {
+	if len(collection.GetFiles()) > 0 {
+		return
+	}
+	resource := proto.GetExtension(
+		message.Desc.Options(),
+		annotations.E_Resource,
+	).(*annotations.ResourceDescriptor)
+	if len(resource.GetPattern()) == 0 {
+		return
+	}
+	pattern, err := parseResourceNamePattern(resource.GetPattern()[0])
+	if err != nil {
+		log.Fatalf("%s: %v", message.Desc.FullName(), err)
+	}
+	if !pattern.IsSingleton() {
+		return
+	}
+	label := collection.GetLabelSingular()
+	if label == "" {
+		label = collection.GetLabel()
+	}
+	collection.Files = []*cmsv1.Collection_File{
+		{
+			Name:  collection.GetName(),
+			Label: label,
+			File:  path.Join(collection.GetFolder(), resource.GetPattern()[0]+fileExtension(collection)),
+		},
+	}
+}
+
+// fileExtension returns the file extension of the entries of a collection.
+func fileExtension(collection *cmsv1.Collection) string {
+	if collection.GetExtension() != "" {
+		return "." + collection.GetExtension()
+	}
+	switch format := collection.GetFileFormat(); {
+	case format == cmsv1.Collection_YAML:
+		return ".yml"
+	case format == cmsv1.Collection_TOML:
+		return ".toml"
+	case isFrontmatterFormat(format):
+		return ".md"
+	default:
+		return ".json"
+	}
+}
+
+func collectFields(collection *cmsv1.Collection, message *protogen.Message, resources resourceIndex) {
+	collection.Fields = append(collection.Fields, inferMessageFields(message, nil, resources)...)
+}
+
+// inferMessageFields infers the fields of a message, with each oneof in place of its first field.
+func inferMessageFields(
+	message *protogen.Message,
+	parentFields []*protogen.Field,
+	resources resourceIndex,
+) []*cmsv1.Field {
+	fields := make([]*cmsv1.Field, 0, len(message.Fields))
 	for _, protoField := range message.Fields {
-		if field, ok := inferField(message, protoField, nil); ok {
-			collection.Fields = append(collection.Fields, field)
+		if oneof := protoField.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
+			if protoField != oneof.Fields[0] {
+				continue
+			}
+			if field, ok := inferOneofField(message, oneof, parentFields, resources); ok {
+				fields = append(fields, field)
+			}
+			continue
+		}
+		if field, ok := inferField(message, protoField, parentFields, resources); ok {
+			fields = append(fields, field)
 		}
 	}
+	return fields
 }
 
 func resolveFieldOwner(fields []*protogen.Field) (*cmsv1.Owner, bool) {
@@ -363,7 +821,23 @@ func resolveFieldOwner(fields []*protogen.Field) (*cmsv1.Owner, bool) {
 }
 
 func inferFieldLabel(field *protogen.Field) string {
-	result := string(field.Desc.Name())
+	return inferLabel(field.Desc.Name())
+}
+
+// inferMessageLabel infers a label from a message name, by splitting it into words at upper case letters.
+func inferMessageLabel(name protoreflect.Name) string {
+	var result strings.Builder
+	for i, r := range string(name) {
+		if i > 0 && unicode.IsUpper(r) {
+			result.WriteRune(' ')
+		}
+		result.WriteRune(unicode.ToUpper(r))
+	}
+	return result.String()
+}
+
+func inferLabel(name protoreflect.Name) string {
+	result := string(name)
 	result = strings.ReplaceAll(result, "_", " ")
 	result = strings.ToUpper(result)
 	return result
@@ -373,6 +847,7 @@ func inferField(
 	protoMessage *protogen.Message,
 	protoField *protogen.Field,
 	parentFields []*protogen.Field,
+	resources resourceIndex,
 ) (*cmsv1.Field, bool) {
 	field := &cmsv1.Field{
 		Name:    string(protoField.Desc.Name()),
@@ -409,15 +884,38 @@ func inferField(
 				StringWidget: &cmsv1.StringWidget{},
 			}
 		}
+		// entries of top-level resources store the final ID of their resource name, see finalIDPattern
+		if _, ok := finalIDPattern(protoMessage); ok && len(parentFields) == 0 {
+			field.Label = "RESOURCE ID"
+			if field.Widget.Hint != "" {
+				field.Widget.Hint += " "
+			}
+			field.Widget.Hint += fmt.Sprintf(
+				"The final ID of the resource name %s, used as the file name of the entry.",
+				resource.GetPattern()[0],
+			)
+			exp := "^" + resourceIDRegexp + "$"
+			field.Widget.Pattern = &cmsv1.Widget_Pattern{
+				Regexp:       exp,
+				ErrorMessage: "Must match " + exp,
+			}
+			return field, true
+		}
 		if len(resource.GetPattern()) > 0 {
-			pattern := resource.GetPattern()[0]
-			exp := regexp.MustCompile(`{.*}`).ReplaceAllString(pattern, `[a-z0-9][a-z0-9-]{0,61}[a-z0-9]`)
-			exp = "^" + exp + "$"
+			patterns := make([]resourceNamePattern, 0, len(resource.GetPattern()))
+			for _, pattern := range resource.GetPattern() {
+				parsed, err := parseResourceNamePattern(pattern)
+				if err != nil {
+					log.Fatalf("%s: %v", protoMessage.Desc.FullName(), err)
+				}
+				patterns = append(patterns, parsed)
+			}
+			exp := inferResourceNameRegexp(patterns)
 			field.Widget.Pattern = &cmsv1.Widget_Pattern{
 				Regexp:       exp,
 				ErrorMessage: "Must match " + exp,
 			}
-			defaultValue := pattern[:strings.Index(pattern, "/")+1]
+			defaultValue := patterns[0].StaticPrefix()
 			if sw, ok := field.GetWidget().GetWidgetType().(*cmsv1.Widget_StringWidget); ok {
 				sw.StringWidget.DefaultValue = defaultValue
 			}
@@ -425,8 +923,24 @@ func inferField(
 		return field, true
 	}
 
+	// the body of a frontmatter entry is stored by Decap CMS in the field named body
+	if field.GetBody() {
+		if protoField.Desc.Kind() != protoreflect.StringKind || protoField.Desc.IsList() || len(parentFields) > 0 {
+			log.Fatalf("%s: body field must be a top-level proto string field", protoField.Desc.FullName())
+		}
+		field.Name = "body"
+		if field.Widget.WidgetType == nil {
+			field.Widget.WidgetType = &cmsv1.Widget_MarkdownWidget{
+				MarkdownWidget: &cmsv1.MarkdownWidget{},
+			}
+		}
+		return field, true
+	}
+
+	checkEnumWidget(field, protoField)
 	// if a widget is specified and is a type that is not able to do more decoration, no further inference
-	if field.Widget.WidgetType != nil && isUnDecoratableWidgetType(field.GetWidget().GetWidgetType()) {
+	if field.Widget.WidgetType != nil && isUnDecoratableWidgetType(field.GetWidget().GetWidgetType()) &&
+		!isEnumSelectField(field, protoField) {
 		return field, true
 	}
 
@@ -437,67 +951,56 @@ func inferField(
 	case "revision_id", "revision_create_time":
 		return nil, false
 	}
+	if hiddenWidget, ok := inferHiddenWidget(protoField); ok {
+		field.Widget.WidgetType = &cmsv1.Widget_HiddenWidget{
+			HiddenWidget: hiddenWidget,
+		}
+		return field, true
+	}
 	switch {
+	case isRelationField(field, protoField):
+		return inferRelationField(field, protoField, resources), true
+	case isMediaField(field, protoField):
+		return inferMediaField(field, protoField), true
 	case protoField.Desc.Kind() == protoreflect.MessageKind &&
-		!protoField.Desc.IsList() &&
-		protoField.Desc.Message().FullName() == "google.protobuf.Timestamp":
-		field.Widget.WidgetType = &cmsv1.Widget_DateTimeWidget{
-			DateTimeWidget: &cmsv1.DateTimeWidget{
-				DateFormat: "YYYY-MM-DD",
-				TimeFormat: "HH:mmZ",
-			},
-		}
+		isWellKnownType(protoField.Desc.Message().FullName()):
 		if protoField.Desc.Name() == "create_time" {
 			field.Widget.RequiredValue = true
 		}
-		return field, true
+		return inferWellKnownTypeField(field, protoField)
+	case protoField.Desc.Kind() == protoreflect.MessageKind &&
+		isCommonType(protoField.Desc.Message().FullName()):
+		return inferCommonTypeField(field, protoField, parentFields, resources)
 	case protoField.Desc.Kind() == protoreflect.BoolKind && !protoField.Desc.IsList():
 		field.Widget.WidgetType = &cmsv1.Widget_BooleanWidget{
 			BooleanWidget: &cmsv1.BooleanWidget{},
 		}
 		return field, true
+	case (protoField.Desc.Kind() == protoreflect.BoolKind || isNumberKind(protoField.Desc.Kind())) &&
+		protoField.Desc.IsList():
+		return inferListField(field, protoField, inferScalarWidget(protoField.Desc.Kind())), true
 	case protoField.Desc.Kind() == protoreflect.StringKind && protoField.Desc.IsList():
+		listWidget := inferListWidget(protoField)
+		if field.Widget.WidgetType != nil {
+			userDefinedListWidget := field.GetWidget().GetWidgetType().(*cmsv1.Widget_ListWidget).ListWidget
+			proto.Merge(listWidget, userDefinedListWidget)
+		}
 		field.Widget.WidgetType = &cmsv1.Widget_ListWidget{
-			ListWidget: &cmsv1.ListWidget{
-				AllowAdd: true,
-			},
+			ListWidget: listWidget,
 		}
 		return field, true
 	case protoField.Desc.Kind() == protoreflect.StringKind && !protoField.Desc.IsList():
 		field.Widget.WidgetType = &cmsv1.Widget_StringWidget{
 			StringWidget: &cmsv1.StringWidget{},
 		}
-		return field, true
-	case protoField.Desc.Kind() == protoreflect.EnumKind:
-		field.Widget.WidgetType = &cmsv1.Widget_SelectWidget{
-			SelectWidget: &cmsv1.SelectWidget{
-				Multiple: protoField.Desc.IsList(),
-			},
-		}
-		var options []*cmsv1.SelectWidget_Option
-		for i := 0; i < protoField.Desc.Enum().Values().Len(); i++ {
-			value := protoField.Desc.Enum().Values().Get(i)
-			if inferRequired(protoField) && strings.HasSuffix(string(value.Name()), "_UNSPECIFIED") {
-				continue
-			}
-			options = append(options, &cmsv1.SelectWidget_Option{
-				Label: strings.ReplaceAll(string(value.Name()), "_", " "),
-				Value: string(value.Name()),
-			})
+		if field.Widget.Pattern == nil {
+			field.Widget.Pattern = inferStringPattern(fieldRules(protoField))
 		}
-		field.Widget.WidgetType.(*cmsv1.Widget_SelectWidget).SelectWidget.Options = options
 		return field, true
+	case isEnumSelectField(field, protoField):
+		return inferEnumField(field, protoField), true
 	case protoField.Desc.Kind() == protoreflect.MessageKind && !protoField.Desc.IsList() && !protoField.Desc.IsMap():
-		objectFields := make([]*cmsv1.Field, 0, len(protoField.Message.Fields))
-		for _, protoObjectField := range protoField.Message.Fields {
-			if objectField, ok := inferField(
-				protoField.Message,
-				protoObjectField,
-				append(parentFields, protoField),
-			); ok {
-				objectFields = append(objectFields, objectField)
-			}
-		}
+		objectFields := inferMessageFields(protoField.Message, append(parentFields, protoField), resources)
 		field.Widget.WidgetType = &cmsv1.Widget_ObjectWidget{
 			ObjectWidget: &cmsv1.ObjectWidget{
 				Collapsed: !inferRequired(protoField),
@@ -506,24 +1009,42 @@ func inferField(
 			},
 		}
 		return field, len(objectFields) > 0
-	case protoField.Desc.Kind() == protoreflect.MessageKind && protoField.Desc.IsList():
-		objectFields := make([]*cmsv1.Field, 0, len(protoField.Message.Fields))
-		for _, protoObjectField := range protoField.Message.Fields {
-			if objectField, ok := inferField(
-				protoField.Message,
-				protoObjectField,
-				append(parentFields, protoField),
-			); ok {
-				objectFields = append(objectFields, objectField)
-			}
+	case protoField.Desc.IsMap():
+		// maps are stored as a list of key/value objects, see package decapjson
+		keyField, ok := inferField(
+			protoField.Message, protoField.Message.Fields[0], append(parentFields, protoField), resources,
+		)
+		if !ok {
+			return nil, false
 		}
-
-		listWidget := &cmsv1.ListWidget{
-			AllowAdd:          true,
-			Collapsed:         !inferRequired(protoField),
-			MinimizeCollapsed: true,
-			Fields:            objectFields,
+		keyField.Widget.RequiredValue = true
+		valueField, ok := inferField(
+			protoField.Message, protoField.Message.Fields[1], append(parentFields, protoField), resources,
+		)
+		if !ok {
+			return nil, false
 		}
+		listWidget := inferListWidget(protoField)
+		listWidget.Collapsed = !inferRequired(protoField)
+		listWidget.MinimizeCollapsed = true
+		listWidget.Summary = "{{fields.key}}"
+		listWidget.Fields = []*cmsv1.Field{keyField, valueField}
+		if field.Widget.WidgetType != nil {
+			userDefinedListWidget := field.GetWidget().GetWidgetType().(*cmsv1.Widget_ListWidget).ListWidget
+			proto.Merge(listWidget, userDefinedListWidget)
+		}
+		field.Widget.WidgetType = &cmsv1.Widget_ListWidget{
+			ListWidget: listWidget,
+		}
+		return field, true
+	case protoField.Desc.Kind() == protoreflect.MessageKind && protoField.Desc.IsList():
+		objectFields := inferMessageFields(protoField.Message, append(parentFields, protoField), resources)
+
+		listWidget := inferListWidget(protoField)
+		listWidget.Collapsed = !inferRequired(protoField)
+		listWidget.MinimizeCollapsed = true
+		listWidget.LabelSingular = inferMessageLabel(protoField.Desc.Message().Name())
+		listWidget.Fields = objectFields
 		if field.Widget.WidgetType != nil {
 			userDefinedListWidget := field.GetWidget().GetWidgetType().(*cmsv1.Widget_ListWidget).ListWidget
 			proto.Merge(listWidget, userDefinedListWidget)
@@ -533,20 +1054,14 @@ func inferField(
 			ListWidget: listWidget,
 		}
 		return field, len(objectFields) > 0
-	case (protoField.Desc.Kind() == protoreflect.DoubleKind ||
-		protoField.Desc.Kind() == protoreflect.FloatKind) && !protoField.Desc.IsList():
+	case isNumberKind(protoField.Desc.Kind()) && !protoField.Desc.IsList():
+		numberWidget := inferNumberWidget(protoField.Desc.Kind())
+		inferNumberRange(numberWidget, fieldRules(protoField))
 		field.Widget.WidgetType = &cmsv1.Widget_NumberWidget{
-			NumberWidget: &cmsv1.NumberWidget{
-				ValueType: cmsv1.NumberWidget_FLOAT,
-			},
+			NumberWidget: numberWidget,
 		}
-		return field, true
-	case (protoField.Desc.Kind() == protoreflect.Int64Kind ||
-		protoField.Desc.Kind() == protoreflect.Int32Kind) && !protoField.Desc.IsList():
-		field.Widget.WidgetType = &cmsv1.Widget_NumberWidget{
-			NumberWidget: &cmsv1.NumberWidget{
-				ValueType: cmsv1.NumberWidget_INT,
-			},
+		if is64BitIntegerKind(protoField.Desc.Kind()) && field.Widget.Pattern == nil {
+			field.Widget.Pattern = inferIntegerPattern(protoField.Desc.Kind())
 		}
 		return field, true
 	}
@@ -562,37 +1077,158 @@ func inferRequired(field *protogen.Field) bool {
 			return true
 		}
 	}
-	return false
+	return fieldRules(field).GetRequired()
 }
 
-type generatedYAMLFile struct {
-	*protogen.GeneratedFile
-	level int
+// inferListField infers a list field of elements edited with the provided widget.
+func inferListField(field *cmsv1.Field, protoField *protogen.Field, element *cmsv1.Widget) *cmsv1.Field {
+	listWidget := inferListWidget(protoField)
+	if objectWidget, ok := element.GetWidgetType().(*cmsv1.Widget_ObjectWidget); ok {
+		listWidget.Collapsed = !inferRequired(protoField)
+		listWidget.MinimizeCollapsed = true
+		listWidget.Summary = objectWidget.ObjectWidget.GetSummary()
+		listWidget.LabelSingular = inferMessageLabel(protoField.Desc.Message().Name())
+		listWidget.Fields = objectWidget.ObjectWidget.GetFields()
+	} else {
+		element.RequiredValue = true
+		listWidget.Field = &cmsv1.Field{
+			Name:   string(protoField.Desc.Name()),
+			Label:  inferFieldLabel(protoField),
+			Widget: element,
+		}
+	}
+	if field.Widget.WidgetType != nil {
+		userDefinedListWidget := field.GetWidget().GetWidgetType().(*cmsv1.Widget_ListWidget).ListWidget
+		proto.Merge(listWidget, userDefinedListWidget)
+	}
+	field.Widget.WidgetType = &cmsv1.Widget_ListWidget{
+		ListWidget: listWidget,
+	}
+	return field
 }
 
-func (g *generatedYAMLFile) I(level int, v ...any) {
-	if level < 1 || len(v) == 0 {
-		g.P(v...)
-	} else {
-		g.P(append([]any{strings.Repeat("  ", level)}, v...)...)
+// inferListWidget infers the list options shared by all list fields.
+// Required list fields must have at least one item, and item limits are inferred from protovalidate rules.
+func inferListWidget(protoField *protogen.Field) *cmsv1.ListWidget {
+	var listWidget cmsv1.ListWidget
+	if inferRequired(protoField) {
+		listWidget.MinItems = 1
 	}
+	inferListRange(&listWidget, fieldRules(protoField))
+	return &listWidget
 }
 
-func (g *generatedYAMLFile) Y(v ...any) {
-	g.I(g.level, v...)
+// inferScalarWidget infers the widget of a bool or numeric list element.
+func inferScalarWidget(kind protoreflect.Kind) *cmsv1.Widget {
+	var widget cmsv1.Widget
+	switch {
+	case kind == protoreflect.BoolKind:
+		widget.WidgetType = &cmsv1.Widget_BooleanWidget{
+			BooleanWidget: &cmsv1.BooleanWidget{},
+		}
+	case isNumberKind(kind):
+		widget.WidgetType = &cmsv1.Widget_NumberWidget{
+			NumberWidget: inferNumberWidget(kind),
+		}
+		if is64BitIntegerKind(kind) {
+			widget.Pattern = inferIntegerPattern(kind)
+		}
+	}
+	return &widget
+}
+
+func isNumberKind(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.DoubleKind, protoreflect.FloatKind:
+		return true
+	}
+	return isIntegerKind(kind)
+}
+
+func isIntegerKind(kind protoreflect.Kind) bool {
+	return is32BitIntegerKind(kind) || is64BitIntegerKind(kind)
+}
+
+func is32BitIntegerKind(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.Int32Kind,
+		protoreflect.Sint32Kind,
+		protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind,
+		protoreflect.Fixed32Kind:
+		return true
+	}
+	return false
+}
+
+func is64BitIntegerKind(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.Int64Kind,
+		protoreflect.Sint64Kind,
+		protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind,
+		protoreflect.Fixed64Kind:
+		return true
+	}
+	return false
+}
+
+func isUnsignedKind(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.Uint32Kind,
+		protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind,
+		protoreflect.Fixed64Kind:
+		return true
+	}
+	return false
 }
 
-func (g *generatedYAMLFile) Up() {
-	g.level++
+// inferNumberWidget infers a number widget for a numeric proto kind.
+//
+// 64-bit integers are stored as strings, the same way protojson encodes them, since their values
+// may exceed the range of integers that JavaScript can represent exactly.
+func inferNumberWidget(kind protoreflect.Kind) *cmsv1.NumberWidget {
+	var result cmsv1.NumberWidget
+	switch {
+	case is32BitIntegerKind(kind):
+		result.ValueType = cmsv1.NumberWidget_INT
+	case is64BitIntegerKind(kind):
+		result.ValueType = cmsv1.NumberWidget_STRING
+	default:
+		result.ValueType = cmsv1.NumberWidget_FLOAT
+	}
+	switch kind {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		result.MinValue = proto.Float64(math.MinInt32)
+		result.MaxValue = proto.Float64(math.MaxInt32)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		result.MinValue = proto.Float64(0)
+		result.MaxValue = proto.Float64(math.MaxUint32)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		result.MinValue = proto.Float64(0)
+	}
+	return &result
 }
 
-func (g *generatedYAMLFile) Down() {
-	g.level--
+// inferIntegerPattern infers a validation pattern for integers stored as strings.
+func inferIntegerPattern(kind protoreflect.Kind) *cmsv1.Widget_Pattern {
+	if isUnsignedKind(kind) {
+		return &cmsv1.Widget_Pattern{
+			Regexp:       `^[0-9]+$`,
+			ErrorMessage: "Must be a non-negative integer",
+		}
+	}
+	return &cmsv1.Widget_Pattern{
+		Regexp:       `^-?[0-9]+$`,
+		ErrorMessage: "Must be an integer",
+	}
 }
 
 func isUnDecoratableWidgetType(t interface{}) bool {
 	switch t.(type) {
-	case *cmsv1.Widget_ListWidget:
+	case *cmsv1.Widget_ListWidget, *cmsv1.Widget_RelationWidget,
+		*cmsv1.Widget_FileWidget, *cmsv1.Widget_ImageWidget:
 		return false
 	default:
 		return true
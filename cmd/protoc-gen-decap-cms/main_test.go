@@ -0,0 +1,235 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// newTestPlugin returns a plugin generating the files, with their imports.
+func newTestPlugin(t *testing.T, files ...protoreflect.FileDescriptor) *protogen.Plugin {
+	t.Helper()
+	var request pluginpb.CodeGeneratorRequest
+	seen := map[string]bool{}
+	var add func(file protoreflect.FileDescriptor)
+	add = func(file protoreflect.FileDescriptor) {
+		if seen[file.Path()] {
+			return
+		}
+		seen[file.Path()] = true
+		for i := 0; i < file.Imports().Len(); i++ {
+			add(file.Imports().Get(i).FileDescriptor)
+		}
+		request.ProtoFile = append(request.ProtoFile, protodesc.ToFileDescriptorProto(file))
+	}
+	for _, file := range files {
+		add(file)
+		request.FileToGenerate = append(request.FileToGenerate, file.Path())
+	}
+	gen, err := protogen.Options{}.New(&request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gen
+}
+
+// newTestMessage returns the message test.v1.Test of a test file with the fields, which may refer to
+// types of the imported files.
+func newTestMessage(t *testing.T, imports []string, fields ...*descriptorpb.FieldDescriptorProto) *protogen.Message {
+	t.Helper()
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("test/v1/test.proto"),
+		Package:    proto.String("test.v1"),
+		Dependency: imports,
+		Syntax:     proto.String("proto3"),
+		Options:    &descriptorpb.FileOptions{GoPackage: proto.String("example.com/test/v1;testv1")},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("Test"), Field: fields},
+		},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return findTestMessage(t, newTestPlugin(t, file), "test.v1.Test")
+}
+
+// findTestMessage returns the message of a plugin with the full name.
+func findTestMessage(t *testing.T, gen *protogen.Plugin, name protoreflect.FullName) *protogen.Message {
+	t.Helper()
+	var find func(messages []*protogen.Message) *protogen.Message
+	find = func(messages []*protogen.Message) *protogen.Message {
+		for _, message := range messages {
+			if message.Desc.FullName() == name {
+				return message
+			}
+			if result := find(message.Messages); result != nil {
+				return result
+			}
+		}
+		return nil
+	}
+	for _, file := range gen.Files {
+		if message := find(file.Messages); message != nil {
+			return message
+		}
+	}
+	t.Fatalf("message %s not found", name)
+	return nil
+}
+
+// findTestField returns the field of a message with the name.
+func findTestField(t *testing.T, message *protogen.Message, name protoreflect.Name) *protogen.Field {
+	t.Helper()
+	for _, field := range message.Fields {
+		if field.Desc.Name() == name {
+			return field
+		}
+	}
+	t.Fatalf("field %s not found in %s", name, message.Desc.FullName())
+	return nil
+}
+
+// expectFatal runs fn in a subprocess running the calling test, and checks that it exits with a
+// log.Fatalf message containing message.
+func expectFatal(t *testing.T, message string, fn func()) {
+	t.Helper()
+	if os.Getenv("EXPECT_FATAL") == t.Name() {
+		fn()
+		os.Exit(0)
+	}
+	names := strings.Split(t.Name(), "/")
+	for i, name := range names {
+		names[i] = "^" + regexp.QuoteMeta(name) + "$"
+	}
+	cmd := exec.Command(os.Args[0], "-test.run="+strings.Join(names, "/"))
+	cmd.Env = append(os.Environ(), "EXPECT_FATAL="+t.Name())
+	output, err := cmd.CombinedOutput()
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		t.Fatalf("expected fatal error %q, got %v: %s", message, err, output)
+	}
+	if !strings.Contains(string(output), message) {
+		t.Errorf("expected fatal error %q, got %s", message, output)
+	}
+}
+
+func TestGenFieldOptions_WidgetTypes(t *testing.T) {
+	widgetTypes := (&cmsv1.Widget{}).ProtoReflect().Descriptor().Oneofs().ByName("widget_type").Fields()
+	for i := 0; i < widgetTypes.Len(); i++ {
+		widgetType := widgetTypes.Get(i)
+		t.Run(string(widgetType.Name()), func(t *testing.T) {
+			widget := &cmsv1.Widget{}
+			widget.ProtoReflect().Set(widgetType, widget.ProtoReflect().NewField(widgetType))
+			actual := genFieldOptions(&cmsv1.Field{Name: "field", Widget: widget})
+			if _, ok := actual.Get("widget"); !ok {
+				t.Errorf("expected a widget, got %v", actual)
+			}
+		})
+	}
+}
+
+func TestGenFieldOptions_UnsupportedWidgetType(t *testing.T) {
+	expectFatal(t, "field: unsupported widget type *cmsv1.StringWidget", func() {
+		genWidgetOptions(&cmsv1.Field{Name: "field"}, &cmsv1.StringWidget{})
+	})
+}
+
+func TestGenFieldOptions_CustomWidget(t *testing.T) {
+	actual := genField(&cmsv1.Field{
+		Name: "field",
+		Widget: &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_CustomWidget{
+				CustomWidget: &cmsv1.CustomWidget{
+					Widget:            "custom",
+					Options:           []string{"default: value", "size: 2"},
+					StructuredOptions: mustNewStruct(t, map[string]any{"multiple": true}),
+				},
+			},
+		},
+	})
+	expected := mapping{
+		{key: "name", value: "field"},
+		{key: "required", value: false},
+		{key: "widget", value: "custom"},
+		{key: "default", value: "value"},
+		{key: "size", value: 2},
+		{key: "multiple", value: true},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestGenFieldOptions_CustomWidgetError(t *testing.T) {
+	for _, tt := range []struct {
+		name              string
+		options           []string
+		structuredOptions map[string]any
+		expected          string
+	}{
+		{
+			name:     "field key",
+			options:  []string{"required: true"},
+			expected: "field: custom widget option required is already set",
+		},
+		{
+			name:     "widget",
+			options:  []string{"widget: string"},
+			expected: "field: custom widget option widget is already set",
+		},
+		{
+			name:     "repeated option",
+			options:  []string{"size: 1", "size: 2"},
+			expected: "field: custom widget option size is already set",
+		},
+		{
+			name:              "structured field key",
+			structuredOptions: map[string]any{"label": "Label"},
+			expected:          "field: custom widget option label is already set",
+		},
+		{
+			name:              "structured option repeating a raw option",
+			options:           []string{"size: 1"},
+			structuredOptions: map[string]any{"size": 2},
+			expected:          "field: custom widget option size is already set",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			expectFatal(t, tt.expected, func() {
+				customWidget := &cmsv1.CustomWidget{Widget: "custom", Options: tt.options}
+				if tt.structuredOptions != nil {
+					customWidget.StructuredOptions = mustNewStruct(t, tt.structuredOptions)
+				}
+				genField(&cmsv1.Field{
+					Name: "field",
+					Widget: &cmsv1.Widget{
+						WidgetType: &cmsv1.Widget_CustomWidget{CustomWidget: customWidget},
+					},
+				})
+			})
+		})
+	}
+}
+
+func mustNewStruct(t *testing.T, value map[string]any) *structpb.Struct {
+	t.Helper()
+	result, err := structpb.NewStruct(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"strings"
+
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// imageFieldSuffixes are the field name suffixes of string fields inferred to hold image URIs.
+var imageFieldSuffixes = []string{"image_uri", "image_uris", "image_url", "image_urls"}
+
+// fileFieldSuffixes are the field name suffixes of string fields inferred to hold file URIs.
+var fileFieldSuffixes = []string{"file_uri", "file_uris", "file_url", "file_urls"}
+
+// isMediaField reports whether a field holds file or image URIs, either by annotation or by name.
+func isMediaField(field *cmsv1.Field, protoField *protogen.Field) bool {
+	switch field.GetWidget().GetWidgetType().(type) {
+	case *cmsv1.Widget_FileWidget, *cmsv1.Widget_ImageWidget:
+		return true
+	}
+	if protoField.Desc.Kind() != protoreflect.StringKind {
+		return false
+	}
+	return hasNameSuffix(protoField, imageFieldSuffixes) || hasNameSuffix(protoField, fileFieldSuffixes)
+}
+
+// inferMediaField infers a file or image widget for a field holding file or image URIs.
+// Repeated fields allow multiple files to be selected from the media library.
+// Options of a user-defined file or image widget take precedence.
+func inferMediaField(field *cmsv1.Field, protoField *protogen.Field) *cmsv1.Field {
+	var mediaLibrary *cmsv1.MediaLibrary
+	if protoField.Desc.IsList() {
+		mediaLibrary = &cmsv1.MediaLibrary{AllowMultiple: proto.Bool(true)}
+	}
+	switch widget := field.GetWidget().GetWidgetType().(type) {
+	case *cmsv1.Widget_FileWidget:
+		fileWidget := &cmsv1.FileWidget{MediaLibrary: mediaLibrary}
+		proto.Merge(fileWidget, widget.FileWidget)
+		widget.FileWidget = fileWidget
+	case *cmsv1.Widget_ImageWidget:
+		imageWidget := &cmsv1.ImageWidget{MediaLibrary: mediaLibrary}
+		proto.Merge(imageWidget, widget.ImageWidget)
+		widget.ImageWidget = imageWidget
+	default:
+		if hasNameSuffix(protoField, imageFieldSuffixes) {
+			field.Widget.WidgetType = &cmsv1.Widget_ImageWidget{
+				ImageWidget: &cmsv1.ImageWidget{MediaLibrary: mediaLibrary},
+			}
+		} else {
+			field.Widget.WidgetType = &cmsv1.Widget_FileWidget{
+				FileWidget: &cmsv1.FileWidget{MediaLibrary: mediaLibrary},
+			}
+		}
+	}
+	return field
+}
+
+func hasNameSuffix(protoField *protogen.Field, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(string(protoField.Desc.Name()), suffix) {
+			return true
+		}
+	}
+	return false
+}
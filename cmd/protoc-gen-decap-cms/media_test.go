@@ -0,0 +1,61 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	examplev1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/example/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestInferMediaField(t *testing.T) {
+	gen := newTestPlugin(t, examplev1.File_einride_decap_cms_example_v1_kitchen_sink_proto)
+	message := findTestMessage(t, gen, "einride.decap.cms.example.v1.KitchenSink")
+	resources := indexResources(message.Desc.ParentFile().Package(), gen.Files)
+	for _, tt := range []struct {
+		field          protoreflect.Name
+		widget         string
+		mediaLibrary   mapping
+		noMediaLibrary bool
+	}{
+		{
+			field:          "cover_image_uri",
+			widget:         "image",
+			noMediaLibrary: true,
+		},
+		{
+			field:        "gallery_image_uris",
+			widget:       "image",
+			mediaLibrary: mapping{{key: "allow_multiple", value: true}},
+		},
+		{
+			field:  "attachment",
+			widget: "file",
+			mediaLibrary: mapping{
+				{key: "choose_url", value: false},
+				{key: "config", value: mapping{{key: "max_file_size", value: 1048576.0}}},
+			},
+		},
+	} {
+		t.Run(string(tt.field), func(t *testing.T) {
+			field, ok := inferField(message, findTestField(t, message, tt.field), nil, resources)
+			if !ok {
+				t.Fatal("expected field")
+			}
+			actual := genField(field)
+			if widget, _ := actual.Get("widget"); widget != tt.widget {
+				t.Errorf("expected widget %s, got %v", tt.widget, widget)
+			}
+			mediaLibrary, ok := actual.Get("media_library")
+			if tt.noMediaLibrary {
+				if ok {
+					t.Errorf("expected no media library, got %v", mediaLibrary)
+				}
+				return
+			}
+			if !reflect.DeepEqual(tt.mediaLibrary, mediaLibrary) {
+				t.Errorf("expected media library %v, got %v", tt.mediaLibrary, mediaLibrary)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"strings"
+
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// inferOneofField infers a field for a oneof, as a list of at most one item with variable types.
+//
+// Each oneof field is a type, and the type of the item is stored in a key named after the oneof.
+// This is converted to the protobuf JSON format by package decapjson.
+func inferOneofField(
+	message *protogen.Message,
+	oneof *protogen.Oneof,
+	parentFields []*protogen.Field,
+	resources resourceIndex,
+) (*cmsv1.Field, bool) {
+	types := make([]*cmsv1.Field, 0, len(oneof.Fields))
+	for _, protoField := range oneof.Fields {
+		field, ok := inferField(message, protoField, parentFields, resources)
+		if !ok {
+			continue
+		}
+		types = append(types, &cmsv1.Field{
+			Name:  field.GetName(),
+			Label: field.GetLabel(),
+			Widget: &cmsv1.Widget{
+				WidgetType: &cmsv1.Widget_ObjectWidget{
+					ObjectWidget: &cmsv1.ObjectWidget{
+						Fields: []*cmsv1.Field{field},
+					},
+				},
+			},
+		})
+	}
+	if len(types) == 0 {
+		return nil, false
+	}
+	return &cmsv1.Field{
+		Name:    string(oneof.Desc.Name()),
+		Label:   inferLabel(oneof.Desc.Name()),
+		Comment: strings.TrimSpace(string(oneof.Comments.Leading)),
+		Widget: &cmsv1.Widget{
+			Hint: strings.TrimSpace(string(oneof.Comments.Leading)),
+			WidgetType: &cmsv1.Widget_ListWidget{
+				ListWidget: &cmsv1.ListWidget{
+					MaxItems: 1,
+					Types:    types,
+					TypeKey:  string(oneof.Desc.Name()),
+				},
+			},
+		},
+	}, true
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// outputFormat is the file format of a generated Decap CMS config.
+type outputFormat string
+
+const (
+	// outputFormatYAML is a YAML config file, loaded by Decap CMS as config.yml.
+	outputFormatYAML outputFormat = "yaml"
+	// outputFormatJSON is a JSON config file.
+	outputFormatJSON outputFormat = "json"
+	// outputFormatJS is an ES module exporting the config object, for manual initialization with CMS.init.
+	outputFormatJS outputFormat = "js"
+)
+
+// outputFormats is a plugin parameter with the formats of the generated config files.
+// The parameter can be repeated to generate the config in multiple formats. Defaults to YAML.
+type outputFormats []outputFormat
+
+// String implements flag.Value.
+func (o *outputFormats) String() string {
+	result := make([]string, 0, len(*o))
+	for _, format := range *o {
+		result = append(result, string(format))
+	}
+	return strings.Join(result, ",")
+}
+
+// Set implements flag.Value.
+func (o *outputFormats) Set(value string) error {
+	switch format := outputFormat(value); format {
+	case outputFormatYAML, outputFormatJSON, outputFormatJS:
+		*o = append(*o, format)
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format %q, must be one of yaml, json or js", value)
+	}
+}
+
+// OrDefault returns the output formats, or YAML if none are set.
+func (o outputFormats) OrDefault() outputFormats {
+	if len(o) == 0 {
+		return outputFormats{outputFormatYAML}
+	}
+	return o
+}
+
+const generatedHeader = "Generated by protoc-gen-decap-cms. DO NOT EDIT."
+
+// marshalOutput marshals a config document to a file in the output format, and returns the file extension.
+func marshalOutput(document mapping, format outputFormat) (string, []byte, error) {
+	switch format {
+	case outputFormatYAML:
+		content, err := marshalYAML(document, generatedHeader)
+		return ".yml", content, err
+	case outputFormatJSON:
+		content, err := marshalJSON(document)
+		return ".json", content, err
+	case outputFormatJS:
+		// the config is passed to CMS.init, so the config file must not be loaded
+		var config mapping
+		config.Set("load_config_file", false)
+		config.Append(document)
+		content, err := marshalJSON(config)
+		if err != nil {
+			return "", nil, err
+		}
+		var result bytes.Buffer
+		result.WriteString("// " + generatedHeader + "\n")
+		result.WriteString("export default ")
+		result.Write(bytes.TrimSuffix(content, []byte("\n")))
+		result.WriteString(";\n")
+		return ".js", result.Bytes(), nil
+	default:
+		return "", nil, fmt.Errorf("unsupported output format %q", format)
+	}
+}
+
+// marshalJSON marshals a config document to indented JSON.
+func marshalJSON(document mapping) ([]byte, error) {
+	compact, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	var result bytes.Buffer
+	if err := json.Indent(&result, compact, "", "  "); err != nil {
+		return nil, err
+	}
+	result.WriteByte('\n')
+	return result.Bytes(), nil
+}
+
+// MarshalJSON implements json.Marshaler, keeping the order of the mapping.
+func (m mapping) MarshalJSON() ([]byte, error) {
+	var result bytes.Buffer
+	result.WriteByte('{')
+	for i, entry := range m {
+		if i > 0 {
+			result.WriteByte(',')
+		}
+		key, err := json.Marshal(entry.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(entry.value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.key, err)
+		}
+		result.Write(key)
+		result.WriteByte(':')
+		result.Write(value)
+	}
+	result.WriteByte('}')
+	return result.Bytes(), nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"log"
+	"regexp"
+
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// resourceIndex indexes the messages of collections by the resource type they declare.
+type resourceIndex map[string]*protogen.Message
+
+func indexResources(pkg protoreflect.FullName, files []*protogen.File) resourceIndex {
+	result := make(resourceIndex)
+	for _, file := range files {
+		if file.Desc.Package() != pkg {
+			continue
+		}
+		for _, message := range file.Messages {
+			if !proto.HasExtension(message.Desc.Options(), cmsv1.E_Collection) {
+				continue
+			}
+			if resource := proto.GetExtension(
+				message.Desc.Options(),
+				annotations.E_Resource,
+			).(*annotations.ResourceDescriptor); resource.GetType() != "" {
+				result[resource.GetType()] = message
+			}
+		}
+	}
+	return result
+}
+
+func resourceReferenceType(protoField *protogen.Field) string {
+	return proto.GetExtension(
+		protoField.Desc.Options(),
+		annotations.E_ResourceReference,
+	).(*annotations.ResourceReference).GetType()
+}
+
+func isRelationField(field *cmsv1.Field, protoField *protogen.Field) bool {
+	if _, ok := field.GetWidget().GetWidgetType().(*cmsv1.Widget_RelationWidget); ok {
+		return true
+	}
+	return protoField.Desc.Kind() == protoreflect.StringKind && resourceReferenceType(protoField) != ""
+}
+
+// inferRelationField infers a relation widget for a resource reference, to the collection of the
+// referenced resource type. The value is the resource name, also for entries that store the final ID
+// of their resource name, see finalIDPattern. Options of a user-defined relation widget take precedence.
+func inferRelationField(field *cmsv1.Field, protoField *protogen.Field, resources resourceIndex) *cmsv1.Field {
+	var userDefinedRelationWidget *cmsv1.RelationWidget
+	if widget, ok := field.GetWidget().GetWidgetType().(*cmsv1.Widget_RelationWidget); ok {
+		userDefinedRelationWidget = widget.RelationWidget
+	}
+	resourceType := resourceReferenceType(protoField)
+	if resourceType == "" {
+		return field
+	}
+	message, ok := resources[resourceType]
+	if !ok {
+		log.Fatalf("%s: unresolvable resource reference to %s", protoField.Desc.FullName(), resourceType)
+	}
+	collection := proto.GetExtension(message.Desc.Options(), cmsv1.E_Collection).(*cmsv1.Collection)
+	displayFields := inferDisplayFields(collection, message)
+	valueField := "name"
+	if pattern, ok := finalIDPattern(message); ok {
+		valueField = pattern.StaticPrefix() + "{{name}}"
+	}
+	relationWidget := &cmsv1.RelationWidget{
+		Collection:    collection.GetName(),
+		ValueField:    valueField,
+		SearchFields:  append([]string{"name"}, displayFields...),
+		DisplayFields: displayFields,
+		Multiple:      protoField.Desc.IsList(),
+	}
+	if userDefinedRelationWidget != nil {
+		if len(userDefinedRelationWidget.GetSearchFields()) > 0 {
+			relationWidget.SearchFields = nil
+		}
+		if len(userDefinedRelationWidget.GetDisplayFields()) > 0 {
+			relationWidget.DisplayFields = nil
+		}
+		proto.Merge(relationWidget, userDefinedRelationWidget)
+	}
+	field.Widget.WidgetType = &cmsv1.Widget_RelationWidget{
+		RelationWidget: relationWidget,
+	}
+	return field
+}
+
+var summaryFieldRegexp = regexp.MustCompile(`{{\s*(?:fields\.)?([a-z0-9_]+)\s*}}`)
+
+// inferDisplayFields infers the fields to display for a related resource, from the fields used in
+// the summary of its collection, or otherwise from its title or display name.
+func inferDisplayFields(collection *cmsv1.Collection, message *protogen.Message) []string {
+	var result []string
+	for _, match := range summaryFieldRegexp.FindAllStringSubmatch(collection.GetSummary(), -1) {
+		if isDisplayField(message, protoreflect.Name(match[1])) {
+			result = append(result, match[1])
+		}
+	}
+	if len(result) > 0 {
+		return result
+	}
+	for _, name := range []protoreflect.Name{"display_name", "title"} {
+		if isDisplayField(message, name) {
+			return []string{string(name)}
+		}
+	}
+	return []string{"name"}
+}
+
+func isDisplayField(message *protogen.Message, name protoreflect.Name) bool {
+	field := message.Desc.Fields().ByName(name)
+	return field != nil && name != "name" && field.Kind() == protoreflect.StringKind && !field.IsList()
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+)
+
+// resourceIDRegexp matches a single resource ID segment of a resource name.
+const resourceIDRegexp = `[a-z0-9][a-z0-9-]{0,61}[a-z0-9]`
+
+// resourceNameSegment is a segment of a resource name pattern.
+type resourceNameSegment struct {
+	// literal of a literal segment, such as "books".
+	literal string
+	// variable of a variable segment, such as "book" for "{book}".
+	variable string
+}
+
+func (s resourceNameSegment) isVariable() bool {
+	return s.variable != ""
+}
+
+// resourceNamePattern is a parsed resource name pattern, such as "shelves/{shelf}/books/{book}".
+type resourceNamePattern []resourceNameSegment
+
+func parseResourceNamePattern(pattern string) (resourceNamePattern, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("empty resource name pattern")
+	}
+	segments := strings.Split(pattern, "/")
+	result := make(resourceNamePattern, 0, len(segments))
+	for _, segment := range segments {
+		switch {
+		case segment == "":
+			return nil, fmt.Errorf("invalid resource name pattern %s: empty segment", pattern)
+		case strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}"):
+			variable := strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}")
+			if variable == "" || strings.ContainsAny(variable, "{}") {
+				return nil, fmt.Errorf("invalid resource name pattern %s: invalid variable %s", pattern, segment)
+			}
+			result = append(result, resourceNameSegment{variable: variable})
+		case strings.ContainsAny(segment, "{}"):
+			return nil, fmt.Errorf("invalid resource name pattern %s: invalid segment %s", pattern, segment)
+		default:
+			result = append(result, resourceNameSegment{literal: segment})
+		}
+	}
+	return result, nil
+}
+
+// IsSingleton reports whether the pattern is of a singleton resource, without variables.
+func (p resourceNamePattern) IsSingleton() bool {
+	for _, segment := range p {
+		if segment.isVariable() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsChildSingleton reports whether the pattern is of a singleton resource of a parent, which ends with
+// a literal segment after a variable, such as "users/{user}/config".
+func (p resourceNamePattern) IsChildSingleton() bool {
+	return len(p) >= 2 && !p[len(p)-1].isVariable() && p[len(p)-2].isVariable()
+}
+
+// IsTopLevel reports whether the pattern is of a top-level resource, whose only variable is its final
+// segment, such as "books/{book}". Final IDs of resources with parents are only unique within their
+// parent.
+func (p resourceNamePattern) IsTopLevel() bool {
+	for _, segment := range p[:len(p)-1] {
+		if segment.isVariable() {
+			return false
+		}
+	}
+	return p[len(p)-1].isVariable()
+}
+
+// Regexp returns a regexp matching resource names of the pattern, without anchors.
+func (p resourceNamePattern) Regexp() string {
+	segments := make([]string, 0, len(p))
+	for _, segment := range p {
+		if segment.isVariable() {
+			segments = append(segments, resourceIDRegexp)
+		} else {
+			segments = append(segments, regexp.QuoteMeta(segment.literal))
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// StaticPrefix returns the static prefix of the pattern, up to the first variable segment.
+func (p resourceNamePattern) StaticPrefix() string {
+	var result strings.Builder
+	for i, segment := range p {
+		if segment.isVariable() {
+			break
+		}
+		result.WriteString(segment.literal)
+		if i < len(p)-1 {
+			result.WriteString("/")
+		}
+	}
+	return result.String()
+}
+
+// inferResourceNameRegexp returns an anchored regexp matching resource names of any of the patterns.
+func inferResourceNameRegexp(patterns []resourceNamePattern) string {
+	if len(patterns) == 1 {
+		return "^" + patterns[0].Regexp() + "$"
+	}
+	alternatives := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		alternatives = append(alternatives, pattern.Regexp())
+	}
+	return "^(?:" + strings.Join(alternatives, "|") + ")$"
+}
+
+// inferNested infers a nested collection config for a hierarchical resource name pattern, such as
+// "publishers/{publisher}/books/{book}", where entries are stored in one subfolder per parent.
+// Singletons of a parent, such as "users/{user}/config", are stored as an index file named after the
+// singleton in one subfolder per parent.
+func inferNested(pattern resourceNamePattern) (*cmsv1.Collection_Nested, bool) {
+	var variables int
+	for _, segment := range pattern {
+		if segment.isVariable() {
+			variables++
+		}
+	}
+	if pattern.IsChildSingleton() {
+		// the meta path of an entry is the IDs of its parents, such as "<user>", and the entry is
+		// stored as "<folder>/<user>/config.json"
+		exp := "^" + strings.TrimSuffix(strings.Repeat(resourceIDRegexp+"/", variables), "/") + "$"
+		return &cmsv1.Collection_Nested{
+			Depth:      int64(variables + 1),
+			Subfolders: true,
+			IndexFile:  pattern[len(pattern)-1].literal,
+			PathLabel:  "PARENT",
+			PathPattern: &cmsv1.Widget_Pattern{
+				Regexp:       exp,
+				ErrorMessage: "Must match " + exp,
+			},
+		}, true
+	}
+	if variables < 2 {
+		return nil, false
+	}
+	// the meta path of an entry is the IDs of its parents and its own ID, such as
+	// "<publisher>/<book>", and the entry is stored as "<folder>/<publisher>/<book>.json"
+	exp := "^" + strings.TrimSuffix(strings.Repeat(resourceIDRegexp+"/", variables), "/") + "$"
+	return &cmsv1.Collection_Nested{
+		Depth:     int64(variables),
+		PathLabel: "PATH",
+		PathPattern: &cmsv1.Widget_Pattern{
+			Regexp:       exp,
+			ErrorMessage: "Must match " + exp,
+		},
+	}, true
+}
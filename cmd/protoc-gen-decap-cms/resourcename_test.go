@@ -0,0 +1,146 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestInferResourceNameRegexp(t *testing.T) {
+	const id = resourceIDRegexp
+	for _, tt := range []struct {
+		name       string
+		patterns   []string
+		expected   string
+		matches    []string
+		nonMatches []string
+	}{
+		{
+			name:       "singleton",
+			patterns:   []string{"settings"},
+			expected:   `^settings$`,
+			matches:    []string{"settings"},
+			nonMatches: []string{"settings/a", "xsettings"},
+		},
+		{
+			name:       "top-level resource",
+			patterns:   []string{"books/{book}"},
+			expected:   `^books/` + id + `$`,
+			matches:    []string{"books/alice-in-wonderland", "books/42"},
+			nonMatches: []string{"books/", "books/a", "books/-a", "books/A1", "shelves/books/ab", "books/ab/c"},
+		},
+		{
+			name:       "nested resource",
+			patterns:   []string{"books/{book}/chapters/{chapter}"},
+			expected:   `^books/` + id + `/chapters/` + id + `$`,
+			matches:    []string{"books/alice/chapters/ch-1"},
+			nonMatches: []string{"books/alice", "books/alice/pages/ch-1"},
+		},
+		{
+			name:     "child singleton",
+			patterns: []string{"users/{user}/config"},
+			expected: `^users/` + id + `/config$`,
+			matches:  []string{"users/alice/config"},
+		},
+		{
+			name:       "literal with regexp metacharacters",
+			patterns:   []string{"a.b/{id}"},
+			expected:   `^a\.b/` + id + `$`,
+			matches:    []string{"a.b/xy"},
+			nonMatches: []string{"axb/xy"},
+		},
+		{
+			name:       "multiple patterns",
+			patterns:   []string{"books/{book}", "shelves/{shelf}/books/{book}"},
+			expected:   `^(?:books/` + id + `|shelves/` + id + `/books/` + id + `)$`,
+			matches:    []string{"books/alice", "shelves/top/books/alice"},
+			nonMatches: []string{"shelves/top", "books/alice/shelves/top/books/alice"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			patterns := make([]resourceNamePattern, 0, len(tt.patterns))
+			for _, pattern := range tt.patterns {
+				parsed, err := parseResourceNamePattern(pattern)
+				if err != nil {
+					t.Fatal(err)
+				}
+				patterns = append(patterns, parsed)
+			}
+			actual := inferResourceNameRegexp(patterns)
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+			exp := regexp.MustCompile(actual)
+			for _, name := range tt.matches {
+				if !exp.MatchString(name) {
+					t.Errorf("expected %s to match %s", actual, name)
+				}
+			}
+			for _, name := range tt.nonMatches {
+				if exp.MatchString(name) {
+					t.Errorf("expected %s not to match %s", actual, name)
+				}
+			}
+		})
+	}
+}
+
+func TestParseResourceNamePattern_Error(t *testing.T) {
+	for _, pattern := range []string{
+		"",
+		"books//{book}",
+		"books/{}",
+		"books/{{book}}",
+		"books/x{book}",
+	} {
+		t.Run(pattern, func(t *testing.T) {
+			if _, err := parseResourceNamePattern(pattern); err == nil {
+				t.Errorf("expected error for %q", pattern)
+			}
+		})
+	}
+}
+
+func TestResourceNamePattern_StaticPrefix(t *testing.T) {
+	for _, tt := range []struct {
+		pattern  string
+		expected string
+	}{
+		{pattern: "settings", expected: "settings"},
+		{pattern: "books/{book}", expected: "books/"},
+		{pattern: "shelves/{shelf}/books/{book}", expected: "shelves/"},
+		{pattern: "{book}", expected: ""},
+	} {
+		t.Run(tt.pattern, func(t *testing.T) {
+			pattern, err := parseResourceNamePattern(tt.pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual := pattern.StaticPrefix(); actual != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestResourceNamePattern_IsTopLevel(t *testing.T) {
+	for _, tt := range []struct {
+		pattern  string
+		expected bool
+	}{
+		{pattern: "settings", expected: false},
+		{pattern: "books/{book}", expected: true},
+		{pattern: "library/books/{book}", expected: true},
+		{pattern: "shelves/{shelf}/books/{book}", expected: false},
+		{pattern: "books/{book}/settings", expected: false},
+	} {
+		t.Run(tt.pattern, func(t *testing.T) {
+			pattern, err := parseResourceNamePattern(tt.pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual := pattern.IsTopLevel(); actual != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"log"
+
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// finalIDPattern returns the resource name pattern of a message whose entries store the final ID of
+// their resource name in the name field, such as "alice-in-wonderland" for "books/alice-in-wonderland".
+// These are folder collections of top-level resources with a single resource name pattern, such as
+// "books/{book}", without files, a nested config, a slug or a path. Decap CMS templates can't take
+// the final segment of a resource name, so the final ID is edited instead, and converted to and from
+// the resource name by package decapjson.
+func finalIDPattern(message *protogen.Message) (resourceNamePattern, bool) {
+	collection := proto.GetExtension(message.Desc.Options(), cmsv1.E_Collection).(*cmsv1.Collection)
+	if collection.GetFolder() == "" || len(collection.GetFiles()) > 0 ||
+		collection.GetSlug() != "" || collection.GetPath() != "" ||
+		collection.GetNested() != nil && !collection.GetNested().GetDisabled() {
+		return nil, false
+	}
+	resource := proto.GetExtension(
+		message.Desc.Options(),
+		annotations.E_Resource,
+	).(*annotations.ResourceDescriptor)
+	if len(resource.GetPattern()) != 1 {
+		return nil, false
+	}
+	pattern, err := parseResourceNamePattern(resource.GetPattern()[0])
+	if err != nil {
+		log.Fatalf("%s: %v", message.Desc.FullName(), err)
+	}
+	if !pattern.IsTopLevel() {
+		return nil, false
+	}
+	return pattern, true
+}
+
+// collectSlug names new entries of collections by the final ID in their name field, see finalIDPattern.
+// A slug or path in the collection annotation takes precedence.
+func collectSlug(collection *cmsv1.Collection, message *protogen.Message) {
+	if _, ok := finalIDPattern(message); ok {
+		collection.Slug = "{{fields.name}}"
+	}
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldRules returns the protovalidate rules of a field, or nil if the field has none.
+func fieldRules(protoField *protogen.Field) *validate.FieldRules {
+	rules, _ := proto.GetExtension(protoField.Desc.Options(), validate.E_Field).(*validate.FieldRules)
+	return rules
+}
+
+// inferNumberRange narrows the range of a number widget to the bounds of the protovalidate rules.
+// Decap CMS bounds are inclusive, so exclusive integer bounds are adjusted by one, while exclusive
+// float bounds are kept as is. A default value outside the range is moved to the nearest inclusive
+// bound, or inside the range for exclusive bounds.
+func inferNumberRange(numberWidget *cmsv1.NumberWidget, rules *validate.FieldRules) {
+	bounds := numberRules(rules)
+	if bounds == nil {
+		return
+	}
+	var exclusiveOffset float64
+	if numberWidget.GetValueType() != cmsv1.NumberWidget_FLOAT {
+		exclusiveOffset = 1
+	}
+	var exclusiveMin, exclusiveMax bool
+	if value, ok := numberRule(bounds, "gte"); ok {
+		numberWidget.MinValue = proto.Float64(value)
+	} else if value, ok := numberRule(bounds, "gt"); ok {
+		numberWidget.MinValue = proto.Float64(value + exclusiveOffset)
+		exclusiveMin = exclusiveOffset == 0
+	}
+	if value, ok := numberRule(bounds, "lte"); ok {
+		numberWidget.MaxValue = proto.Float64(value)
+	} else if value, ok := numberRule(bounds, "lt"); ok {
+		numberWidget.MaxValue = proto.Float64(value - exclusiveOffset)
+		exclusiveMax = exclusiveOffset == 0
+	}
+	defaultValue := numberWidget.GetDefaultValue()
+	belowMin := numberWidget.MinValue != nil &&
+		(defaultValue < numberWidget.GetMinValue() || exclusiveMin && defaultValue == numberWidget.GetMinValue())
+	aboveMax := numberWidget.MaxValue != nil &&
+		(defaultValue > numberWidget.GetMaxValue() || exclusiveMax && defaultValue == numberWidget.GetMaxValue())
+	switch {
+	case !belowMin && !aboveMax:
+	case (exclusiveMin || exclusiveMax) && numberWidget.MinValue != nil && numberWidget.MaxValue != nil:
+		numberWidget.DefaultValue = (numberWidget.GetMinValue() + numberWidget.GetMaxValue()) / 2
+	case belowMin && exclusiveMin:
+		numberWidget.DefaultValue = numberWidget.GetMinValue() + 1
+	case belowMin:
+		numberWidget.DefaultValue = numberWidget.GetMinValue()
+	case aboveMax && exclusiveMax:
+		numberWidget.DefaultValue = numberWidget.GetMaxValue() - 1
+	case aboveMax:
+		numberWidget.DefaultValue = numberWidget.GetMaxValue()
+	}
+}
+
+// typeRules returns the name and the type rules of the protovalidate rules, or nil if they have none.
+func typeRules(rules *validate.FieldRules) (protoreflect.Name, protoreflect.Message) {
+	if rules == nil {
+		return "", nil
+	}
+	message := rules.ProtoReflect()
+	typeField := message.WhichOneof(message.Descriptor().Oneofs().ByName("type"))
+	if typeField == nil {
+		return "", nil
+	}
+	return typeField.Name(), message.Get(typeField).Message()
+}
+
+// numberRules returns the numeric type rules of the protovalidate rules, or nil if they have none.
+func numberRules(rules *validate.FieldRules) protoreflect.Message {
+	switch name, result := typeRules(rules); name {
+	case "float", "double",
+		"int32", "int64", "uint32", "uint64", "sint32", "sint64",
+		"fixed32", "fixed64", "sfixed32", "sfixed64":
+		return result
+	}
+	return nil
+}
+
+// inferHiddenWidget infers a hidden widget storing the constant value of the protovalidate rules,
+// for fields that are stored but not edited, such as discriminators.
+func inferHiddenWidget(protoField *protogen.Field) (*cmsv1.HiddenWidget, bool) {
+	if protoField.Desc.IsList() {
+		return nil, false
+	}
+	name, rules := typeRules(fieldRules(protoField))
+	if rules == nil {
+		return nil, false
+	}
+	constField := rules.Descriptor().Fields().ByName("const")
+	if constField == nil || !rules.Has(constField) {
+		return nil, false
+	}
+	var result cmsv1.HiddenWidget
+	switch value := rules.Get(constField).Interface().(type) {
+	case bool:
+		result.DefaultValue = &cmsv1.HiddenWidget_DefaultBool{DefaultBool: value}
+	case string:
+		result.DefaultValue = &cmsv1.HiddenWidget_DefaultString{DefaultString: value}
+	case int32:
+		if name == "enum" {
+			enumValue := protoField.Desc.Enum().Values().ByNumber(protoreflect.EnumNumber(value))
+			if enumValue == nil {
+				return nil, false
+			}
+			result.DefaultValue = &cmsv1.HiddenWidget_DefaultString{DefaultString: string(enumValue.Name())}
+		} else {
+			result.DefaultValue = &cmsv1.HiddenWidget_DefaultInt64{DefaultInt64: int64(value)}
+		}
+	case int64:
+		// 64-bit integers are stored as strings, like the values of their number widgets
+		result.DefaultValue = &cmsv1.HiddenWidget_DefaultString{DefaultString: strconv.FormatInt(value, 10)}
+	case uint32:
+		result.DefaultValue = &cmsv1.HiddenWidget_DefaultInt64{DefaultInt64: int64(value)}
+	case uint64:
+		result.DefaultValue = &cmsv1.HiddenWidget_DefaultString{DefaultString: strconv.FormatUint(value, 10)}
+	case float32:
+		result.DefaultValue = &cmsv1.HiddenWidget_DefaultDouble{DefaultDouble: float64(value)}
+	case float64:
+		result.DefaultValue = &cmsv1.HiddenWidget_DefaultDouble{DefaultDouble: value}
+	default:
+		return nil, false
+	}
+	return &result, true
+}
+
+// maxSafeInteger is the largest integer that is exactly representable as a float64, and therefore as a
+// number in the generated config.
+const maxSafeInteger = 1<<53 - 1
+
+// numberRule returns a numeric rule of the type rules as a float64.
+// 64-bit integer rules beyond the range of exactly representable integers are ignored.
+func numberRule(typeRules protoreflect.Message, name protoreflect.Name) (float64, bool) {
+	field := typeRules.Descriptor().Fields().ByName(name)
+	if field == nil || !typeRules.Has(field) {
+		return 0, false
+	}
+	switch value := typeRules.Get(field).Interface().(type) {
+	case int32:
+		return float64(value), true
+	case int64:
+		if value > maxSafeInteger || value < -maxSafeInteger {
+			return 0, false
+		}
+		return float64(value), true
+	case uint32:
+		return float64(value), true
+	case uint64:
+		if value > maxSafeInteger {
+			return 0, false
+		}
+		return float64(value), true
+	case float32:
+		return float64(value), true
+	case float64:
+		return value, true
+	}
+	return 0, false
+}
+
+// inferStringPattern infers a validation pattern from the protovalidate string rules.
+// An explicit pattern takes precedence over length rules, since Decap CMS supports a single pattern.
+func inferStringPattern(rules *validate.FieldRules) *cmsv1.Widget_Pattern {
+	stringRules := rules.GetString()
+	switch {
+	case stringRules.GetPattern() != "":
+		return &cmsv1.Widget_Pattern{
+			Regexp:       stringRules.GetPattern(),
+			ErrorMessage: "Must match " + stringRules.GetPattern(),
+		}
+	case stringRules.HasMaxLen():
+		return &cmsv1.Widget_Pattern{
+			Regexp: fmt.Sprintf(`^[\s\S]{%d,%d}$`, stringRules.GetMinLen(), stringRules.GetMaxLen()),
+			ErrorMessage: fmt.Sprintf(
+				"Must be between %d and %d characters", stringRules.GetMinLen(), stringRules.GetMaxLen(),
+			),
+		}
+	case stringRules.GetMinLen() > 0:
+		return &cmsv1.Widget_Pattern{
+			Regexp:       fmt.Sprintf(`^[\s\S]{%d,}$`, stringRules.GetMinLen()),
+			ErrorMessage: fmt.Sprintf("Must be at least %d characters", stringRules.GetMinLen()),
+		}
+	}
+	return nil
+}
+
+// inferListRange infers the item limits of a list from the protovalidate repeated or map rules.
+func inferListRange(listWidget *cmsv1.ListWidget, rules *validate.FieldRules) {
+	switch {
+	case rules.GetRepeated() != nil:
+		if rules.GetRepeated().GetMinItems() > 0 {
+			listWidget.MinItems = int64(rules.GetRepeated().GetMinItems())
+		}
+		if rules.GetRepeated().HasMaxItems() {
+			listWidget.MaxItems = int64(rules.GetRepeated().GetMaxItems())
+		}
+	case rules.GetMap() != nil:
+		if rules.GetMap().GetMinPairs() > 0 {
+			listWidget.MinItems = int64(rules.GetMap().GetMinPairs())
+		}
+		if rules.GetMap().HasMaxPairs() {
+			listWidget.MaxItems = int64(rules.GetMap().GetMaxPairs())
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestInferNumberRange(t *testing.T) {
+	for _, tt := range []struct {
+		name            string
+		kind            protoreflect.Kind
+		rules           *validate.FieldRules
+		defaultValue    float64
+		expectedMin     *float64
+		expectedMax     *float64
+		expectedDefault float64
+	}{
+		{
+			name: "int32 inclusive bounds",
+			kind: protoreflect.Int32Kind,
+			rules: validate.FieldRules_builder{
+				Int32: validate.Int32Rules_builder{Gte: proto.Int32(1), Lte: proto.Int32(5)}.Build(),
+			}.Build(),
+			expectedMin:     proto.Float64(1),
+			expectedMax:     proto.Float64(5),
+			expectedDefault: 1,
+		},
+		{
+			name: "int32 exclusive bounds",
+			kind: protoreflect.Int32Kind,
+			rules: validate.FieldRules_builder{
+				Int32: validate.Int32Rules_builder{Gt: proto.Int32(0), Lt: proto.Int32(10)}.Build(),
+			}.Build(),
+			expectedMin:     proto.Float64(1),
+			expectedMax:     proto.Float64(9),
+			expectedDefault: 1,
+		},
+		{
+			name: "int32 default above the maximum",
+			kind: protoreflect.Int32Kind,
+			rules: validate.FieldRules_builder{
+				Int32: validate.Int32Rules_builder{Lte: proto.Int32(-1)}.Build(),
+			}.Build(),
+			expectedMin:     proto.Float64(math.MinInt32),
+			expectedMax:     proto.Float64(-1),
+			expectedDefault: -1,
+		},
+		{
+			name: "int32 default inside the range",
+			kind: protoreflect.Int32Kind,
+			rules: validate.FieldRules_builder{
+				Int32: validate.Int32Rules_builder{Gte: proto.Int32(-5), Lte: proto.Int32(5)}.Build(),
+			}.Build(),
+			defaultValue:    3,
+			expectedMin:     proto.Float64(-5),
+			expectedMax:     proto.Float64(5),
+			expectedDefault: 3,
+		},
+		{
+			name: "uint64 exclusive minimum",
+			kind: protoreflect.Uint64Kind,
+			rules: validate.FieldRules_builder{
+				Uint64: validate.UInt64Rules_builder{Gt: proto.Uint64(0), Lte: proto.Uint64(100)}.Build(),
+			}.Build(),
+			expectedMin:     proto.Float64(1),
+			expectedMax:     proto.Float64(100),
+			expectedDefault: 1,
+		},
+		{
+			name: "uint64 maximum beyond 2^53",
+			kind: protoreflect.Uint64Kind,
+			rules: validate.FieldRules_builder{
+				Uint64: validate.UInt64Rules_builder{Lte: proto.Uint64(1 << 60)}.Build(),
+			}.Build(),
+			expectedMin:     proto.Float64(0),
+			expectedDefault: 0,
+		},
+		{
+			name: "int64 minimum beyond -2^53",
+			kind: protoreflect.Int64Kind,
+			rules: validate.FieldRules_builder{
+				Int64: validate.Int64Rules_builder{Gte: proto.Int64(-1 << 60), Lte: proto.Int64(maxSafeInteger)}.Build(),
+			}.Build(),
+			expectedMax:     proto.Float64(maxSafeInteger),
+			expectedDefault: 0,
+		},
+		{
+			name: "double inclusive bounds",
+			kind: protoreflect.DoubleKind,
+			rules: validate.FieldRules_builder{
+				Double: validate.DoubleRules_builder{Gte: proto.Float64(2), Lte: proto.Float64(3)}.Build(),
+			}.Build(),
+			expectedMin:     proto.Float64(2),
+			expectedMax:     proto.Float64(3),
+			expectedDefault: 2,
+		},
+		{
+			name: "double exclusive bounds",
+			kind: protoreflect.DoubleKind,
+			rules: validate.FieldRules_builder{
+				Double: validate.DoubleRules_builder{Gt: proto.Float64(0), Lt: proto.Float64(1)}.Build(),
+			}.Build(),
+			expectedMin:     proto.Float64(0),
+			expectedMax:     proto.Float64(1),
+			expectedDefault: 0.5,
+		},
+		{
+			name: "double exclusive minimum",
+			kind: protoreflect.DoubleKind,
+			rules: validate.FieldRules_builder{
+				Double: validate.DoubleRules_builder{Gt: proto.Float64(0)}.Build(),
+			}.Build(),
+			expectedMin:     proto.Float64(0),
+			expectedDefault: 1,
+		},
+		{
+			name: "double exclusive maximum",
+			kind: protoreflect.DoubleKind,
+			rules: validate.FieldRules_builder{
+				Double: validate.DoubleRules_builder{Lt: proto.Float64(0)}.Build(),
+			}.Build(),
+			expectedMax:     proto.Float64(0),
+			expectedDefault: -1,
+		},
+		{
+			name: "float exclusive minimum",
+			kind: protoreflect.FloatKind,
+			rules: validate.FieldRules_builder{
+				Float: validate.FloatRules_builder{Gt: proto.Float32(0.5)}.Build(),
+			}.Build(),
+			expectedMin:     proto.Float64(0.5),
+			expectedDefault: 1.5,
+		},
+		{
+			name:            "no number rules",
+			kind:            protoreflect.Int64Kind,
+			rules:           validate.FieldRules_builder{Required: proto.Bool(true)}.Build(),
+			expectedDefault: 0,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			numberWidget := inferNumberWidget(tt.kind)
+			numberWidget.DefaultValue = tt.defaultValue
+			inferNumberRange(numberWidget, tt.rules)
+			if expected, actual := optionalFloat(tt.expectedMin), optionalFloat(numberWidget.MinValue); expected != actual {
+				t.Errorf("expected min %v, got %v", expected, actual)
+			}
+			if expected, actual := optionalFloat(tt.expectedMax), optionalFloat(numberWidget.MaxValue); expected != actual {
+				t.Errorf("expected max %v, got %v", expected, actual)
+			}
+			if numberWidget.GetDefaultValue() != tt.expectedDefault {
+				t.Errorf("expected default %v, got %v", tt.expectedDefault, numberWidget.GetDefaultValue())
+			}
+		})
+	}
+}
+
+// optionalFloat returns the value of an optional float, or "none" if it is unset.
+func optionalFloat(value *float64) any {
+	if value == nil {
+		return "none"
+	}
+	return *value
+}
+
+func TestInferHiddenWidget(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		kind     descriptorpb.FieldDescriptorProto_Type
+		rules    *validate.FieldRules
+		expected any
+	}{
+		{
+			name: "string",
+			kind: descriptorpb.FieldDescriptorProto_TYPE_STRING,
+			rules: validate.FieldRules_builder{
+				String: validate.StringRules_builder{Const: proto.String("kitchen-sink")}.Build(),
+			}.Build(),
+			expected: "kitchen-sink",
+		},
+		{
+			name: "bool",
+			kind: descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+			rules: validate.FieldRules_builder{
+				Bool: validate.BoolRules_builder{Const: proto.Bool(true)}.Build(),
+			}.Build(),
+			expected: true,
+		},
+		{
+			name: "uint32",
+			kind: descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+			rules: validate.FieldRules_builder{
+				Uint32: validate.UInt32Rules_builder{Const: proto.Uint32(42)}.Build(),
+			}.Build(),
+			expected: int64(42),
+		},
+		{
+			name: "int64 as string",
+			kind: descriptorpb.FieldDescriptorProto_TYPE_INT64,
+			rules: validate.FieldRules_builder{
+				Int64: validate.Int64Rules_builder{Const: proto.Int64(-1 << 60)}.Build(),
+			}.Build(),
+			expected: "-1152921504606846976",
+		},
+		{
+			name: "uint64 as string",
+			kind: descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+			rules: validate.FieldRules_builder{
+				Uint64: validate.UInt64Rules_builder{Const: proto.Uint64(1<<64 - 1)}.Build(),
+			}.Build(),
+			expected: "18446744073709551615",
+		},
+		{
+			name: "fixed64 as string",
+			kind: descriptorpb.FieldDescriptorProto_TYPE_FIXED64,
+			rules: validate.FieldRules_builder{
+				Fixed64: validate.Fixed64Rules_builder{Const: proto.Uint64(7)}.Build(),
+			}.Build(),
+			expected: "7",
+		},
+		{
+			name: "double",
+			kind: descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+			rules: validate.FieldRules_builder{
+				Double: validate.DoubleRules_builder{Const: proto.Float64(0.5)}.Build(),
+			}.Build(),
+			expected: 0.5,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			options := &descriptorpb.FieldOptions{}
+			proto.SetExtension(options, validate.E_Field, tt.rules)
+			message := newTestMessage(t, []string{"buf/validate/validate.proto"}, &descriptorpb.FieldDescriptorProto{
+				Name:    proto.String("value"),
+				Number:  proto.Int32(1),
+				Label:   descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:    tt.kind.Enum(),
+				Options: options,
+			})
+			hiddenWidget, ok := inferHiddenWidget(message.Fields[0])
+			if !ok {
+				t.Fatal("expected a hidden widget")
+			}
+			actual := genFieldOptions(&cmsv1.Field{
+				Widget: &cmsv1.Widget{WidgetType: &cmsv1.Widget_HiddenWidget{HiddenWidget: hiddenWidget}},
+			})
+			if value, _ := actual.Get("default"); value != tt.expected {
+				t.Errorf("expected default %v (%T), got %v (%T)", tt.expected, tt.expected, value, value)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// isWellKnownType reports whether a message is a google.protobuf well-known type with a dedicated widget.
+// Other google.protobuf messages, such as the descriptor types, are edited as ordinary messages.
+func isWellKnownType(message protoreflect.FullName) bool {
+	switch message {
+	case "google.protobuf.Timestamp",
+		"google.protobuf.Duration",
+		"google.protobuf.Struct",
+		"google.protobuf.Value",
+		"google.protobuf.ListValue",
+		"google.protobuf.Any",
+		"google.protobuf.FieldMask",
+		"google.protobuf.Empty",
+		"google.protobuf.StringValue",
+		"google.protobuf.BytesValue",
+		"google.protobuf.BoolValue",
+		"google.protobuf.DoubleValue",
+		"google.protobuf.FloatValue",
+		"google.protobuf.Int64Value",
+		"google.protobuf.UInt64Value",
+		"google.protobuf.Int32Value",
+		"google.protobuf.UInt32Value":
+		return true
+	}
+	return false
+}
+
+// inferWellKnownTypeField infers a field for a google.protobuf well-known type.
+//
+// The widgets store values in the protobuf JSON format of the well-known type, except for
+// the JSON types (Struct, Value, ListValue and Any), which are stored as a string of JSON code,
+// FieldMask, which is stored as a list of paths, and Empty, which is stored as a boolean for its
+// presence, see package decapjson.
+func inferWellKnownTypeField(field *cmsv1.Field, protoField *protogen.Field) (*cmsv1.Field, bool) {
+	widget, ok := inferWellKnownTypeWidget(protoField.Desc.Message().FullName())
+	if !ok {
+		return nil, false
+	}
+	if protoField.Desc.IsList() {
+		return inferListField(field, protoField, widget), true
+	}
+	field.Widget.WidgetType = widget.GetWidgetType()
+	if field.Widget.Pattern == nil {
+		field.Widget.Pattern = widget.GetPattern()
+	}
+	return field, true
+}
+
+func inferWellKnownTypeWidget(message protoreflect.FullName) (*cmsv1.Widget, bool) {
+	switch message {
+	case "google.protobuf.Timestamp":
+		// stored in UTC as RFC 3339 with seconds, as required by protojson
+		return &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_DateTimeWidget{
+				DateTimeWidget: &cmsv1.DateTimeWidget{
+					Format:     "YYYY-MM-DDTHH:mm:ss[Z]",
+					DateFormat: "YYYY-MM-DD",
+					TimeFormat: "HH:mm:ss",
+					PickerUtc:  true,
+				},
+			},
+		}, true
+	case "google.protobuf.Duration":
+		return &cmsv1.Widget{
+			Pattern: &cmsv1.Widget_Pattern{
+				Regexp:       `^-?[0-9]+(\.[0-9]{1,9})?s$`,
+				ErrorMessage: "Must be a duration in seconds, such as 3.5s",
+			},
+			WidgetType: &cmsv1.Widget_StringWidget{
+				StringWidget: &cmsv1.StringWidget{},
+			},
+		}, true
+	case "google.protobuf.Struct",
+		"google.protobuf.Value",
+		"google.protobuf.ListValue",
+		"google.protobuf.Any":
+		return &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_CodeWidget{
+				CodeWidget: &cmsv1.CodeWidget{
+					DefaultLanguage: "json",
+					OutputCodeOnly:  true,
+				},
+			},
+		}, true
+	case "google.protobuf.FieldMask":
+		return &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_ListWidget{
+				ListWidget: &cmsv1.ListWidget{},
+			},
+		}, true
+	case "google.protobuf.StringValue":
+		return &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_StringWidget{
+				StringWidget: &cmsv1.StringWidget{},
+			},
+		}, true
+	case "google.protobuf.BytesValue":
+		return &cmsv1.Widget{
+			Pattern: &cmsv1.Widget_Pattern{
+				Regexp:       `^[A-Za-z0-9+/_-]*={0,2}$`,
+				ErrorMessage: "Must be base64 encoded",
+			},
+			WidgetType: &cmsv1.Widget_StringWidget{
+				StringWidget: &cmsv1.StringWidget{},
+			},
+		}, true
+	case "google.protobuf.Empty":
+		// Empty has no value to edit, so its presence is edited instead, present by default so that
+		// choosing an Empty member of a oneof sets it
+		return &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_BooleanWidget{
+				BooleanWidget: &cmsv1.BooleanWidget{DefaultValue: proto.Bool(true)},
+			},
+		}, true
+	case "google.protobuf.BoolValue":
+		return &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_BooleanWidget{
+				BooleanWidget: &cmsv1.BooleanWidget{},
+			},
+		}, true
+	case "google.protobuf.DoubleValue",
+		"google.protobuf.FloatValue",
+		"google.protobuf.Int64Value",
+		"google.protobuf.UInt64Value",
+		"google.protobuf.Int32Value",
+		"google.protobuf.UInt32Value":
+		// the kind of the wrapper is the kind of its value field
+		kind := wrapperKind(message)
+		numberWidget := inferNumberWidget(kind)
+		numberWidget.AllowEmpty = true
+		widget := &cmsv1.Widget{
+			WidgetType: &cmsv1.Widget_NumberWidget{
+				NumberWidget: numberWidget,
+			},
+		}
+		if is64BitIntegerKind(kind) {
+			widget.Pattern = inferIntegerPattern(kind)
+		}
+		return widget, true
+	}
+	return nil, false
+}
+
+func wrapperKind(message protoreflect.FullName) protoreflect.Kind {
+	switch message {
+	case "google.protobuf.DoubleValue":
+		return protoreflect.DoubleKind
+	case "google.protobuf.FloatValue":
+		return protoreflect.FloatKind
+	case "google.protobuf.Int64Value":
+		return protoreflect.Int64Kind
+	case "google.protobuf.UInt64Value":
+		return protoreflect.Uint64Kind
+	case "google.protobuf.Int32Value":
+		return protoreflect.Int32Kind
+	case "google.protobuf.UInt32Value":
+		return protoreflect.Uint32Kind
+	case "google.protobuf.BoolValue":
+		return protoreflect.BoolKind
+	case "google.protobuf.BytesValue":
+		return protoreflect.BytesKind
+	default:
+		return protoreflect.StringKind
+	}
+}
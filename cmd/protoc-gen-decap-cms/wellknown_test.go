@@ -0,0 +1,58 @@
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestInferField_BooleanDefault(t *testing.T) {
+	message := newTestMessage(
+		t,
+		[]string{"google/protobuf/empty.proto", "google/protobuf/wrappers.proto"},
+		&descriptorpb.FieldDescriptorProto{
+			Name:   proto.String("bool_value"),
+			Number: proto.Int32(1),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum(),
+		},
+		&descriptorpb.FieldDescriptorProto{
+			Name:     proto.String("nullable_bool_value"),
+			Number:   proto.Int32(2),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String(".google.protobuf.BoolValue"),
+		},
+		&descriptorpb.FieldDescriptorProto{
+			Name:     proto.String("empty"),
+			Number:   proto.Int32(3),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String(".google.protobuf.Empty"),
+		},
+	)
+	for _, tt := range []struct {
+		field    protoreflect.Name
+		expected any
+	}{
+		{field: "bool_value"},
+		{field: "nullable_bool_value"},
+		{field: "empty", expected: true},
+	} {
+		t.Run(string(tt.field), func(t *testing.T) {
+			field, ok := inferField(message, findTestField(t, message, tt.field), nil, nil)
+			if !ok {
+				t.Fatal("expected field")
+			}
+			actual := genField(field)
+			if widget, _ := actual.Get("widget"); widget != "boolean" {
+				t.Fatalf("expected a boolean widget, got %v", widget)
+			}
+			if value, _ := actual.Get("default"); value != tt.expected {
+				t.Errorf("expected default %v, got %v", tt.expected, value)
+			}
+		})
+	}
+}
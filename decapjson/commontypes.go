@@ -0,0 +1,256 @@
+package decapjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func isCommonType(message protoreflect.MessageDescriptor) bool {
+	switch message.FullName() {
+	case "google.type.Date",
+		"google.type.TimeOfDay",
+		"google.type.LatLng",
+		"google.type.Money",
+		"google.type.Color":
+		return true
+	}
+	return false
+}
+
+func fromDecapCommonType(message protoreflect.MessageDescriptor, value any) (any, error) {
+	switch message.FullName() {
+	case "google.type.Date":
+		// stored as YYYY-MM-DD
+		s, ok := value.(string)
+		if !ok {
+			return value, nil
+		}
+		date, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date: %w", err)
+		}
+		return map[string]any{
+			"year":  date.Year(),
+			"month": int(date.Month()),
+			"day":   date.Day(),
+		}, nil
+	case "google.type.TimeOfDay":
+		// stored as HH:mm:ss
+		s, ok := value.(string)
+		if !ok {
+			return value, nil
+		}
+		timeOfDay, err := time.Parse(time.TimeOnly, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time of day: %w", err)
+		}
+		return map[string]any{
+			"hours":   timeOfDay.Hour(),
+			"minutes": timeOfDay.Minute(),
+			"seconds": timeOfDay.Second(),
+		}, nil
+	case "google.type.LatLng":
+		// stored as a GeoJSON point
+		s, ok := value.(string)
+		if !ok {
+			return value, nil
+		}
+		var point struct {
+			Type        string    `json:"type"`
+			Coordinates []float64 `json:"coordinates"`
+		}
+		if err := json.Unmarshal([]byte(s), &point); err != nil {
+			return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+		}
+		if point.Type != "Point" || len(point.Coordinates) != 2 {
+			return nil, fmt.Errorf("invalid GeoJSON point: %s", s)
+		}
+		return map[string]any{
+			"latitude":  point.Coordinates[1],
+			"longitude": point.Coordinates[0],
+		}, nil
+	case "google.type.Money":
+		// stored as a currency code and a decimal amount
+		object, ok := value.(map[string]any)
+		if !ok {
+			return value, nil
+		}
+		amount, ok := object["amount"]
+		if !ok {
+			return value, nil
+		}
+		units, nanos, err := parseDecimal(fmt.Sprint(amount))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{
+			"currency_code": object["currency_code"],
+			"units":         strconv.FormatInt(units, 10),
+			"nanos":         nanos,
+		}, nil
+	case "google.type.Color":
+		// stored as a CSS color
+		s, ok := value.(string)
+		if !ok {
+			return value, nil
+		}
+		return parseColor(s)
+	}
+	return value, nil
+}
+
+func toDecapCommonType(message protoreflect.MessageDescriptor, value any) any {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return value
+	}
+	switch message.FullName() {
+	case "google.type.Date":
+		return fmt.Sprintf(
+			"%04d-%02d-%02d", intValue(object["year"]), intValue(object["month"]), intValue(object["day"]),
+		)
+	case "google.type.TimeOfDay":
+		return fmt.Sprintf(
+			"%02d:%02d:%02d", intValue(object["hours"]), intValue(object["minutes"]), intValue(object["seconds"]),
+		)
+	case "google.type.LatLng":
+		point, err := json.Marshal(map[string]any{
+			"type":        "Point",
+			"coordinates": []float64{floatValue(object["longitude"]), floatValue(object["latitude"])},
+		})
+		if err != nil {
+			return value
+		}
+		return string(point)
+	case "google.type.Money":
+		return map[string]any{
+			"currency_code": object["currency_code"],
+			"amount":        formatDecimal(int64(intValue(object["units"])), int32(intValue(object["nanos"]))),
+		}
+	case "google.type.Color":
+		return formatColor(object)
+	}
+	return value
+}
+
+func intValue(value any) int {
+	switch value := value.(type) {
+	case json.Number:
+		result, _ := strconv.Atoi(value.String())
+		return result
+	case string:
+		result, _ := strconv.Atoi(value)
+		return result
+	}
+	return 0
+}
+
+func floatValue(value any) float64 {
+	switch value := value.(type) {
+	case json.Number:
+		result, _ := value.Float64()
+		return result
+	}
+	return 0
+}
+
+var decimalRegexp = regexp.MustCompile(`^(-?)([0-9]+)(?:\.([0-9]{1,9}))?$`)
+
+// parseDecimal parses a decimal amount into units and nanos.
+func parseDecimal(s string) (int64, int32, error) {
+	match := decimalRegexp.FindStringSubmatch(s)
+	if match == nil {
+		return 0, 0, fmt.Errorf("invalid decimal amount: %s", s)
+	}
+	units, err := strconv.ParseInt(match[2], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid decimal amount: %w", err)
+	}
+	var nanos int64
+	if match[3] != "" {
+		nanos, _ = strconv.ParseInt(match[3]+strings.Repeat("0", 9-len(match[3])), 10, 32)
+	}
+	if match[1] == "-" {
+		units, nanos = -units, -nanos
+	}
+	return units, int32(nanos), nil
+}
+
+// formatDecimal formats units and nanos as a decimal amount.
+func formatDecimal(units int64, nanos int32) string {
+	var sign string
+	if units < 0 || nanos < 0 {
+		sign = "-"
+	}
+	result := sign + strconv.FormatInt(abs(units), 10)
+	if nanos != 0 {
+		result += "." + strings.TrimRight(fmt.Sprintf("%09d", abs(int64(nanos))), "0")
+	}
+	return result
+}
+
+func abs(i int64) int64 {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+var rgbaRegexp = regexp.MustCompile(
+	`^rgba?\(\s*([0-9]+)\s*,\s*([0-9]+)\s*,\s*([0-9]+)\s*(?:,\s*([0-9.]+)\s*)?\)$`,
+)
+
+// parseColor parses a CSS hex or rgba color, as written by the color widget.
+func parseColor(s string) (any, error) {
+	var red, green, blue, alpha float64
+	alpha = 1
+	switch {
+	case strings.HasPrefix(s, "#") && (len(s) == 7 || len(s) == 9):
+		rgba, err := strconv.ParseUint(s[1:], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid color: %s", s)
+		}
+		if len(s) == 9 {
+			alpha = float64(rgba&0xff) / 255
+			rgba >>= 8
+		}
+		red, green, blue = float64(rgba>>16&0xff), float64(rgba>>8&0xff), float64(rgba&0xff)
+	case rgbaRegexp.MatchString(s):
+		match := rgbaRegexp.FindStringSubmatch(s)
+		red, _ = strconv.ParseFloat(match[1], 64)
+		green, _ = strconv.ParseFloat(match[2], 64)
+		blue, _ = strconv.ParseFloat(match[3], 64)
+		if match[4] != "" {
+			alpha, _ = strconv.ParseFloat(match[4], 64)
+		}
+	default:
+		return nil, fmt.Errorf("invalid color: %s", s)
+	}
+	result := map[string]any{
+		"red":   red / 255,
+		"green": green / 255,
+		"blue":  blue / 255,
+	}
+	if alpha != 1 {
+		result["alpha"] = alpha
+	}
+	return result, nil
+}
+
+// formatColor formats a color as a CSS hex color, or an rgba color if it's not opaque.
+func formatColor(object map[string]any) string {
+	red := int(math.Round(floatValue(object["red"]) * 255))
+	green := int(math.Round(floatValue(object["green"]) * 255))
+	blue := int(math.Round(floatValue(object["blue"]) * 255))
+	if alpha, ok := object["alpha"]; ok && floatValue(alpha) != 1 {
+		return fmt.Sprintf("rgba(%d, %d, %d, %s)", red, green, blue, strconv.FormatFloat(floatValue(alpha), 'f', -1, 64))
+	}
+	return fmt.Sprintf("#%02x%02x%02x", red, green, blue)
+}
@@ -0,0 +1,92 @@
+package decapjson
+
+import (
+	"testing"
+
+	examplev1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/example/v1"
+	"google.golang.org/genproto/googleapis/type/color"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/genproto/googleapis/type/timeofday"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUnmarshal_CommonTypes(t *testing.T) {
+	runUnmarshalTests(t, []unmarshalTest{
+		{
+			name: "common types",
+			content: `{
+				"date": "2023-01-15",
+				"time_of_day": "13:58:30",
+				"location": "{\"type\":\"Point\",\"coordinates\":[11.97,57.7]}",
+				"price": {"currency_code": "SEK", "amount": "-12.5"},
+				"color": "#ff0033"
+			}`,
+			expected: &examplev1.KitchenSink{
+				Date:      &date.Date{Year: 2023, Month: 1, Day: 15},
+				TimeOfDay: &timeofday.TimeOfDay{Hours: 13, Minutes: 58, Seconds: 30},
+				Location:  &latlng.LatLng{Latitude: 57.7, Longitude: 11.97},
+				Price:     &money.Money{CurrencyCode: "SEK", Units: -12, Nanos: -500000000},
+				Color:     &color.Color{Red: 1, Blue: 0.2},
+			},
+		},
+		{
+			name:    "rgba color",
+			content: `{"color": "rgba(255, 0, 51, 0.25)"}`,
+			expected: &examplev1.KitchenSink{
+				Color: &color.Color{Red: 1, Blue: 0.2, Alpha: wrapperspb.Float(0.25)},
+			},
+		},
+	})
+}
+
+func TestUnmarshal_CommonTypesError(t *testing.T) {
+	runUnmarshalErrorTests(t, []unmarshalErrorTest{
+		{
+			name:    "invalid date",
+			content: `{"date": "2023-01-15T13:58:30Z"}`,
+		},
+		{
+			name:    "invalid color",
+			content: `{"color": "red"}`,
+		},
+	})
+}
+
+func TestMarshal_CommonTypes(t *testing.T) {
+	runMarshalTests(t, []marshalTest{
+		{
+			name: "common types",
+			message: &examplev1.KitchenSink{
+				Date:      &date.Date{Year: 2023, Month: 1, Day: 15},
+				TimeOfDay: &timeofday.TimeOfDay{Hours: 13, Minutes: 58},
+				Location:  &latlng.LatLng{Latitude: 57.7, Longitude: 11.97},
+				Price:     &money.Money{CurrencyCode: "SEK", Units: 12, Nanos: 50000000},
+				Color:     &color.Color{Red: 1, Blue: 0.2, Alpha: wrapperspb.Float(0.25)},
+			},
+			expected: `{
+				"date": "2023-01-15",
+				"time_of_day": "13:58:00",
+				"location": "{\"coordinates\":[11.97,57.7],\"type\":\"Point\"}",
+				"price": {"currency_code": "SEK", "amount": "12.05"},
+				"color": "rgba(255, 0, 51, 0.25)"
+			}`,
+		},
+	})
+}
+
+func TestMarshal_CommonTypesRoundTrip(t *testing.T) {
+	runRoundTripTests(t, []roundTripTest{
+		{
+			name: "common types",
+			message: &examplev1.KitchenSink{
+				Date:      &date.Date{Year: 2023, Month: 12, Day: 31},
+				TimeOfDay: &timeofday.TimeOfDay{Hours: 23, Minutes: 59, Seconds: 59},
+				Location:  &latlng.LatLng{Latitude: -33.9, Longitude: 18.4},
+				Price:     &money.Money{CurrencyCode: "EUR", Units: -3, Nanos: -990000000},
+				Color:     &color.Color{Red: 0.2, Green: 1},
+			},
+		},
+	})
+}
@@ -0,0 +1,368 @@
+// Package decapjson converts between content written by Decap CMS and the protobuf JSON format.
+//
+// Some protobuf constructs have no direct counterpart among the Decap CMS widgets, and are
+// therefore stored by protoc-gen-decap-cms in a different shape than the one expected by protojson.
+// For example, map fields are edited as a list of key/value objects, and google.protobuf.Struct
+// fields are edited as a string of JSON code. The google.type common types are stored in the format
+// of their widgets, such as a YYYY-MM-DD string for google.type.Date. Oneofs are edited as a list of
+// at most one item, with one item type per oneof field.
+//
+// Decap CMS stores empty string values for widgets that have been cleared, which are dropped for
+// fields that don't accept strings.
+//
+// Entries of top-level resources store the final ID of their resource name in the name field, such as
+// "alice-in-wonderland" for "books/alice-in-wonderland", which names the file of the entry. The final
+// ID is expanded to the resource name when reading content, and the resource name is shortened to its
+// final ID when writing content.
+//
+// Use Unmarshal to read Decap CMS content into a message, and Marshal to write a message as
+// Decap CMS content. Use UnmarshalFrontmatter and MarshalFrontmatter for Markdown content with
+// frontmatter, where the body of the content is the field marked as the body.
+package decapjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fromDecapOneof converts a list of at most one item with the type of a oneof field, to the oneof
+// field in the result object.
+//
+// The type of the item is stored in a key named after the oneof, and the value of the oneof field is
+// stored in a key named after the oneof field.
+func fromDecapOneof(oneof protoreflect.OneofDescriptor, value any, result map[string]any) error {
+	items, ok := value.([]any)
+	if !ok {
+		return fmt.Errorf("oneof is not a list: %v", value)
+	}
+	switch len(items) {
+	case 0:
+		return nil
+	case 1:
+	default:
+		return fmt.Errorf("oneof has more than one item")
+	}
+	item, ok := items[0].(map[string]any)
+	if !ok {
+		return fmt.Errorf("oneof item is not an object: %v", items[0])
+	}
+	name, ok := item[string(oneof.Name())].(string)
+	if !ok {
+		return fmt.Errorf("oneof item has no type")
+	}
+	field := oneof.Fields().ByName(protoreflect.Name(name))
+	if field == nil {
+		return fmt.Errorf("unknown oneof type: %s", name)
+	}
+	fieldValue, ok := item[name]
+	if !ok || (fieldValue == "" && !acceptsString(field)) {
+		return nil
+	}
+	converted, err := fromDecapField(field, fieldValue)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	result[name] = converted
+	return nil
+}
+
+// toDecapOneof converts the set field of a oneof in the object, to a list of one item.
+func toDecapOneof(oneof protoreflect.OneofDescriptor, object map[string]any) {
+	for i := 0; i < oneof.Fields().Len(); i++ {
+		name := string(oneof.Fields().Get(i).Name())
+		fieldValue, ok := object[name]
+		if !ok {
+			continue
+		}
+		delete(object, name)
+		object[string(oneof.Name())] = []any{
+			map[string]any{
+				string(oneof.Name()): name,
+				name:                 fieldValue,
+			},
+		}
+		return
+	}
+}
+
+// Keys of the objects that map entries are stored as.
+const (
+	mapEntryKey   = "key"
+	mapEntryValue = "value"
+)
+
+// Unmarshal reads the Decap CMS JSON content b into the message m.
+func Unmarshal(b []byte, m proto.Message) error {
+	content, err := decode(b)
+	if err != nil {
+		return err
+	}
+	if prefix, ok := finalIDPrefix(m.ProtoReflect().Descriptor()); ok {
+		fromDecapFinalID(prefix, content)
+	}
+	content, err = fromDecapMessage(m.ProtoReflect().Descriptor(), content)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+	return protojson.Unmarshal(data, m)
+}
+
+// Marshal writes the message m as Decap CMS JSON content.
+func Marshal(m proto.Message) ([]byte, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	content, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+	content = toDecapMessage(m.ProtoReflect().Descriptor(), content)
+	if prefix, ok := finalIDPrefix(m.ProtoReflect().Descriptor()); ok {
+		toDecapFinalID(prefix, content)
+	}
+	return json.MarshalIndent(content, "", "  ")
+}
+
+func decode(b []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var result any
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func findField(message protoreflect.MessageDescriptor, key string) protoreflect.FieldDescriptor {
+	if field := message.Fields().ByName(protoreflect.Name(key)); field != nil {
+		return field
+	}
+	return message.Fields().ByJSONName(key)
+}
+
+func fromDecapMessage(message protoreflect.MessageDescriptor, value any) (any, error) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return value, nil
+	}
+	result := make(map[string]any, len(object))
+	for key, fieldValue := range object {
+		if oneof := message.Oneofs().ByName(protoreflect.Name(key)); oneof != nil && !oneof.IsSynthetic() {
+			if err := fromDecapOneof(oneof, fieldValue, result); err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			continue
+		}
+		field := findField(message, key)
+		if field == nil {
+			result[key] = fieldValue
+			continue
+		}
+		if fieldValue == "" && !acceptsString(field) {
+			continue
+		}
+		converted, err := fromDecapField(field, fieldValue)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		result[key] = converted
+	}
+	return result, nil
+}
+
+func fromDecapField(field protoreflect.FieldDescriptor, value any) (any, error) {
+	switch {
+	case field.IsMap():
+		return fromDecapMap(field, value)
+	case field.IsList():
+		list, ok := value.([]any)
+		if !ok {
+			// Media widgets allowing multiple files may store a single file as a string.
+			if s, ok := value.(string); ok && s != "" && field.Kind() == protoreflect.StringKind {
+				return []any{s}, nil
+			}
+			return value, nil
+		}
+		result := make([]any, 0, len(list))
+		for _, element := range list {
+			converted, err := fromDecapValue(field, element)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, converted)
+		}
+		return result, nil
+	default:
+		return fromDecapValue(field, value)
+	}
+}
+
+func fromDecapValue(field protoreflect.FieldDescriptor, value any) (any, error) {
+	if field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind {
+		if isWellKnownType(field.Message()) {
+			return fromDecapWellKnownType(field.Message(), value)
+		}
+		if isCommonType(field.Message()) {
+			return fromDecapCommonType(field.Message(), value)
+		}
+		return fromDecapMessage(field.Message(), value)
+	}
+	return value, nil
+}
+
+// acceptsString reports whether the protobuf JSON value of a field can be an empty string.
+func acceptsString(field protoreflect.FieldDescriptor) bool {
+	if field.IsList() || field.IsMap() {
+		return false
+	}
+	switch field.Kind() {
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return true
+	case protoreflect.MessageKind:
+		switch field.Message().FullName() {
+		case "google.protobuf.StringValue", "google.protobuf.BytesValue", "google.protobuf.FieldMask":
+			return true
+		}
+	}
+	return false
+}
+
+// fromDecapMap converts a list of key/value objects to a JSON object.
+func fromDecapMap(field protoreflect.FieldDescriptor, value any) (any, error) {
+	entries, ok := value.([]any)
+	if !ok {
+		return value, nil
+	}
+	result := make(map[string]any, len(entries))
+	for _, entry := range entries {
+		object, ok := entry.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("map entry is not an object: %v", entry)
+		}
+		key, err := formatMapKey(object[mapEntryKey])
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := result[key]; ok {
+			return nil, fmt.Errorf("duplicate map key: %s", key)
+		}
+		converted, err := fromDecapValue(field.MapValue(), object[mapEntryValue])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		result[key] = converted
+	}
+	return result, nil
+}
+
+func formatMapKey(key any) (string, error) {
+	switch key := key.(type) {
+	case string:
+		return key, nil
+	case json.Number:
+		return key.String(), nil
+	case bool:
+		return strconv.FormatBool(key), nil
+	default:
+		return "", fmt.Errorf("invalid map key: %v", key)
+	}
+}
+
+func toDecapMessage(message protoreflect.MessageDescriptor, value any) any {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return value
+	}
+	for key, fieldValue := range object {
+		if field := findField(message, key); field != nil {
+			object[key] = toDecapField(field, fieldValue)
+		}
+	}
+	for i := 0; i < message.Oneofs().Len(); i++ {
+		if oneof := message.Oneofs().Get(i); !oneof.IsSynthetic() {
+			toDecapOneof(oneof, object)
+		}
+	}
+	return object
+}
+
+func toDecapField(field protoreflect.FieldDescriptor, value any) any {
+	switch {
+	case field.IsMap():
+		return toDecapMap(field, value)
+	case field.IsList():
+		list, ok := value.([]any)
+		if !ok {
+			return value
+		}
+		for i, element := range list {
+			list[i] = toDecapValue(field, element)
+		}
+		return list
+	default:
+		return toDecapValue(field, value)
+	}
+}
+
+func toDecapValue(field protoreflect.FieldDescriptor, value any) any {
+	if field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind {
+		if isWellKnownType(field.Message()) {
+			return toDecapWellKnownType(field.Message(), value)
+		}
+		if isCommonType(field.Message()) {
+			return toDecapCommonType(field.Message(), value)
+		}
+		return toDecapMessage(field.Message(), value)
+	}
+	return value
+}
+
+// toDecapMap converts a JSON object to a list of key/value objects, ordered by key.
+func toDecapMap(field protoreflect.FieldDescriptor, value any) any {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return value
+	}
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := make([]any, 0, len(object))
+	for _, key := range keys {
+		result = append(result, map[string]any{
+			mapEntryKey:   parseMapKey(field.MapKey(), key),
+			mapEntryValue: toDecapValue(field.MapValue(), object[key]),
+		})
+	}
+	return result
+}
+
+// parseMapKey parses a map key into the type written by the widget of the map key.
+func parseMapKey(field protoreflect.FieldDescriptor, key string) any {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		if value, err := strconv.ParseBool(key); err == nil {
+			return value
+		}
+	case protoreflect.Int32Kind,
+		protoreflect.Sint32Kind,
+		protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind,
+		protoreflect.Fixed32Kind:
+		return json.Number(key)
+	}
+	return key
+}
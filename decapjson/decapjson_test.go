@@ -0,0 +1,299 @@
+package decapjson
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	examplev1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/example/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestUnmarshal(t *testing.T) {
+	runUnmarshalTests(t, []unmarshalTest{
+		{
+			name:    "scalars",
+			content: `{"display_name": "Example", "example_enum": "TWO", "int64_value": "42"}`,
+			expected: &examplev1.KitchenSink{
+				DisplayName: "Example",
+				ExampleEnum: examplev1.KitchenSink_TWO,
+				Int64Value:  42,
+			},
+		},
+		{
+			name: "string map",
+			content: `{"labels": [
+				{"key": "a", "value": "1"},
+				{"key": "b", "value": "2"}
+			]}`,
+			expected: &examplev1.KitchenSink{
+				Labels: map[string]string{"a": "1", "b": "2"},
+			},
+		},
+		{
+			name:    "message map with integer keys",
+			content: `{"spec_map": [{"key": 1, "value": {"name": "spec", "count": 2}}]}`,
+			expected: &examplev1.KitchenSink{
+				SpecMap: map[int32]*examplev1.KitchenSink_SomeSpec{1: {Name: "spec", Count: 2}},
+			},
+		},
+		{
+			name:     "empty map",
+			content:  `{"labels": []}`,
+			expected: &examplev1.KitchenSink{},
+		},
+		{
+			name:    "oneof string",
+			content: `{"example_oneof": [{"example_oneof": "oneof_string", "oneof_string": "value"}]}`,
+			expected: &examplev1.KitchenSink{
+				ExampleOneof: &examplev1.KitchenSink_OneofString{OneofString: "value"},
+			},
+		},
+		{
+			name:    "oneof message",
+			content: `{"example_oneof": [{"example_oneof": "oneof_spec", "oneof_spec": {"name": "spec"}}]}`,
+			expected: &examplev1.KitchenSink{
+				ExampleOneof: &examplev1.KitchenSink_OneofSpec{OneofSpec: &examplev1.KitchenSink_SomeSpec{Name: "spec"}},
+			},
+		},
+		{
+			name:     "empty oneof",
+			content:  `{"example_oneof": []}`,
+			expected: &examplev1.KitchenSink{},
+		},
+		{
+			name:     "final ID in the name field",
+			content:  `{"name": "example"}`,
+			expected: &examplev1.KitchenSink{Name: "kitchenSinks/example"},
+		},
+		{
+			name:     "resource name in the name field",
+			content:  `{"name": "kitchenSinks/example"}`,
+			expected: &examplev1.KitchenSink{Name: "kitchenSinks/example"},
+		},
+		{
+			name:    "single media file in a list field",
+			content: `{"gallery_image_uris": "/uploads/cover.png"}`,
+			expected: &examplev1.KitchenSink{
+				GalleryImageUris: []string{"/uploads/cover.png"},
+			},
+		},
+	})
+}
+
+func TestUnmarshal_Error(t *testing.T) {
+	runUnmarshalErrorTests(t, []unmarshalErrorTest{
+		{
+			name:    "duplicate map key",
+			content: `{"labels": [{"key": "a", "value": "1"}, {"key": "a", "value": "2"}]}`,
+		},
+		{
+			name:    "map entry that is not an object",
+			content: `{"labels": ["a"]}`,
+		},
+		{
+			name:    "oneof with more than one item",
+			content: `{"example_oneof": [{"example_oneof": "oneof_string"}, {"example_oneof": "oneof_string"}]}`,
+		},
+		{
+			name:    "unknown oneof type",
+			content: `{"example_oneof": [{"example_oneof": "unknown"}]}`,
+		},
+	})
+}
+
+func TestMarshal(t *testing.T) {
+	runMarshalTests(t, []marshalTest{
+		{
+			name: "maps as lists of key/value objects",
+			message: &examplev1.KitchenSink{
+				Labels:  map[string]string{"b": "2", "a": "1"},
+				SpecMap: map[int32]*examplev1.KitchenSink_SomeSpec{1: {Name: "spec"}},
+			},
+			expected: `{
+				"labels": [{"key": "a", "value": "1"}, {"key": "b", "value": "2"}],
+				"spec_map": [{"key": 1, "value": {"name": "spec"}}]
+			}`,
+		},
+		{
+			name:     "resource name as final ID",
+			message:  &examplev1.KitchenSink{Name: "kitchenSinks/example"},
+			expected: `{"name": "example"}`,
+		},
+		{
+			name: "oneof as a list of one item",
+			message: &examplev1.KitchenSink{
+				ExampleOneof: &examplev1.KitchenSink_OneofString{OneofString: "value"},
+			},
+			expected: `{"example_oneof": [{"example_oneof": "oneof_string", "oneof_string": "value"}]}`,
+		},
+	})
+}
+
+func TestMarshal_RoundTrip(t *testing.T) {
+	runRoundTripTests(t, []roundTripTest{
+		{
+			name: "scalars",
+			message: &examplev1.KitchenSink{
+				Name:         "kitchenSinks/example",
+				DisplayName:  "Example",
+				ExampleEnum:  examplev1.KitchenSink_TWO,
+				DoubleValue:  0.42,
+				Int64Value:   42,
+				Uint32Value:  7,
+				Fixed64Value: 1 << 60,
+				BoolValues:   []bool{true, false},
+				ExampleEnums: []examplev1.KitchenSink_ExampleEnum{examplev1.KitchenSink_ONE, examplev1.KitchenSink_TWO},
+			},
+		},
+		{
+			name: "maps",
+			message: &examplev1.KitchenSink{
+				Labels:  map[string]string{"a": "1", "b": "2"},
+				SpecMap: map[int32]*examplev1.KitchenSink_SomeSpec{-1: {Name: "spec", Count: 2}},
+			},
+		},
+		{
+			name: "oneof message",
+			message: &examplev1.KitchenSink{
+				ExampleOneof: &examplev1.KitchenSink_OneofSpec{OneofSpec: &examplev1.KitchenSink_SomeSpec{Name: "spec"}},
+			},
+		},
+		{
+			name: "media lists",
+			message: &examplev1.KitchenSink{
+				CoverImageUri:    "/uploads/cover.png",
+				GalleryImageUris: []string{"/uploads/a.png", "/uploads/b.png"},
+			},
+		},
+	})
+}
+
+func TestUnmarshal_Examples(t *testing.T) {
+	for _, tt := range []struct {
+		pattern string
+		message proto.Message
+	}{
+		{pattern: "../example/books/*.json", message: &examplev1.Book{}},
+		{pattern: "../example/chapters/*/*.json", message: &examplev1.Chapter{}},
+		{pattern: "../example/kitchenSinks/*.json", message: &examplev1.KitchenSink{}},
+	} {
+		files, err := filepath.Glob(tt.pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, file := range files {
+			t.Run(file, func(t *testing.T) {
+				data, err := os.ReadFile(file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := Unmarshal(data, proto.Clone(tt.message)); err != nil {
+					t.Error(err)
+				}
+			})
+		}
+	}
+}
+
+type unmarshalTest struct {
+	name     string
+	content  string
+	expected *examplev1.KitchenSink
+}
+
+func runUnmarshalTests(t *testing.T, tests []unmarshalTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual examplev1.KitchenSink
+			if err := Unmarshal([]byte(tt.content), &actual); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(tt.expected, &actual) {
+				t.Errorf("expected %v, got %v", tt.expected, &actual)
+			}
+		})
+	}
+}
+
+type unmarshalErrorTest struct {
+	name    string
+	content string
+}
+
+func runUnmarshalErrorTests(t *testing.T, tests []unmarshalErrorTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual examplev1.KitchenSink
+			if err := Unmarshal([]byte(tt.content), &actual); err == nil {
+				t.Errorf("expected error, got %v", &actual)
+			}
+		})
+	}
+}
+
+type marshalTest struct {
+	name     string
+	message  *examplev1.KitchenSink
+	expected string
+}
+
+func runMarshalTests(t *testing.T, tests []marshalTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Marshal(tt.message)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var actual, expected any
+			if err := json.Unmarshal(data, &actual); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.expected), &expected); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(expected, actual) {
+				t.Errorf("expected %s, got %s", tt.expected, data)
+			}
+		})
+	}
+}
+
+type roundTripTest struct {
+	name    string
+	message *examplev1.KitchenSink
+}
+
+func runRoundTripTests(t *testing.T, tests []roundTripTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Marshal(tt.message)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var actual examplev1.KitchenSink
+			if err := Unmarshal(data, &actual); err != nil {
+				t.Fatalf("%v: %s", err, data)
+			}
+			if !proto.Equal(tt.message, &actual) {
+				t.Errorf("expected %v, got %v", tt.message, &actual)
+			}
+		})
+	}
+}
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	result, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+package decapjson
+
+import (
+	"strings"
+
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// finalIDPrefix returns the resource name prefix of Decap CMS entries of a message that store the
+// final ID of their resource name in the name field, such as "books/" for "books/{book}".
+// protoc-gen-decap-cms edits the final ID for folder collections of top-level resources with a single
+// resource name pattern, and names new entries by it.
+func finalIDPrefix(message protoreflect.MessageDescriptor) (string, bool) {
+	collection := proto.GetExtension(message.Options(), cmsv1.E_Collection).(*cmsv1.Collection)
+	if collection.GetFolder() == "" || len(collection.GetFiles()) > 0 ||
+		collection.GetSlug() != "" || collection.GetPath() != "" ||
+		collection.GetNested() != nil && !collection.GetNested().GetDisabled() {
+		return "", false
+	}
+	resource := proto.GetExtension(message.Options(), annotations.E_Resource).(*annotations.ResourceDescriptor)
+	if len(resource.GetPattern()) != 1 {
+		return "", false
+	}
+	pattern := resource.GetPattern()[0]
+	index := strings.LastIndex(pattern, "/") + 1
+	prefix, variable := pattern[:index], pattern[index:]
+	if strings.Contains(prefix, "{") || !strings.HasPrefix(variable, "{") {
+		return "", false
+	}
+	return prefix, true
+}
+
+// fromDecapFinalID expands the final ID in the name field of the content to the resource name.
+// Resource names are kept as is.
+func fromDecapFinalID(prefix string, value any) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return
+	}
+	if name, _ := object["name"].(string); name != "" && !strings.Contains(name, "/") {
+		object["name"] = prefix + name
+	}
+}
+
+// toDecapFinalID shortens the resource name in the name field of the content to its final ID.
+func toDecapFinalID(prefix string, value any) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return
+	}
+	if name, _ := object["name"].(string); strings.HasPrefix(name, prefix) {
+		object["name"] = strings.TrimPrefix(name, prefix)
+	}
+}
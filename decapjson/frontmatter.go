@@ -0,0 +1,205 @@
+package decapjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	cmsv1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// yamlDelimiter delimits YAML frontmatter, the default frontmatter of Decap CMS.
+	yamlDelimiter = "---"
+	// tomlDelimiter delimits TOML frontmatter.
+	tomlDelimiter = "+++"
+	// bodyKey is the key of the body of entries of collections without a body field.
+	bodyKey = "body"
+)
+
+// UnmarshalFrontmatter reads the Decap CMS Markdown content b, with YAML or JSON frontmatter, into
+// the message m. The body following the frontmatter is read into the field marked as the body by
+// the field annotation, or else into a field named body.
+func UnmarshalFrontmatter(b []byte, m proto.Message) error {
+	content, body, err := splitFrontmatter(b)
+	if err != nil {
+		return err
+	}
+	if len(body) > 0 {
+		content[bodyFieldKey(m.ProtoReflect().Descriptor())] = string(body)
+	}
+	data, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+	return Unmarshal(data, m)
+}
+
+// MarshalFrontmatter writes the message m as Decap CMS Markdown content with YAML frontmatter.
+// The field marked as the body by the field annotation, or else a field named body, is written as
+// the body following the frontmatter.
+func MarshalFrontmatter(m proto.Message) ([]byte, error) {
+	data, err := Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	content := document.Content[0]
+	key := bodyFieldKey(m.ProtoReflect().Descriptor())
+	var body string
+	for i := 0; i+1 < len(content.Content); i += 2 {
+		if content.Content[i].Value == key {
+			body = content.Content[i+1].Value
+			content.Content = append(content.Content[:i], content.Content[i+2:]...)
+			break
+		}
+	}
+	// the content is decoded from JSON, so reset its flow and quoting styles to block YAML
+	clearStyle(content)
+	var result bytes.Buffer
+	result.WriteString(yamlDelimiter + "\n")
+	if len(content.Content) > 0 {
+		encoder := yaml.NewEncoder(&result)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(content); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+	}
+	result.WriteString(yamlDelimiter + "\n")
+	result.WriteString(body)
+	return result.Bytes(), nil
+}
+
+// bodyFieldKey returns the key that the body of Markdown entries of a message is read into.
+func bodyFieldKey(message protoreflect.MessageDescriptor) string {
+	for i := 0; i < message.Fields().Len(); i++ {
+		field := message.Fields().Get(i)
+		if proto.GetExtension(field.Options(), cmsv1.E_Field).(*cmsv1.Field).GetBody() {
+			return string(field.Name())
+		}
+	}
+	return bodyKey
+}
+
+// splitFrontmatter splits Markdown content into its frontmatter, as a JSON object, and its body.
+// Content without frontmatter is all body.
+func splitFrontmatter(b []byte) (map[string]any, []byte, error) {
+	switch {
+	case bytes.HasPrefix(b, []byte(yamlDelimiter)):
+		return splitYAMLFrontmatter(b)
+	case bytes.HasPrefix(b, []byte(tomlDelimiter)):
+		return nil, nil, errors.New("unsupported TOML frontmatter")
+	case bytes.HasPrefix(b, []byte("{")):
+		return splitJSONFrontmatter(b)
+	default:
+		return map[string]any{}, b, nil
+	}
+}
+
+func splitYAMLFrontmatter(b []byte) (map[string]any, []byte, error) {
+	lines := bytes.SplitAfter(b, []byte("\n"))
+	if string(bytes.TrimRight(lines[0], "\r\n")) != yamlDelimiter {
+		return map[string]any{}, b, nil
+	}
+	offset := len(lines[0])
+	for _, line := range lines[1:] {
+		if string(bytes.TrimRight(line, "\r\n")) != yamlDelimiter {
+			offset += len(line)
+			continue
+		}
+		var document yaml.Node
+		if err := yaml.Unmarshal(b[len(lines[0]):offset], &document); err != nil {
+			return nil, nil, err
+		}
+		content, err := jsonValue(&document)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch content := content.(type) {
+		case nil:
+			return map[string]any{}, b[offset+len(line):], nil
+		case map[string]any:
+			return content, b[offset+len(line):], nil
+		default:
+			return nil, nil, errors.New("frontmatter is not a YAML mapping")
+		}
+	}
+	return nil, nil, errors.New("unterminated YAML frontmatter")
+}
+
+func splitJSONFrontmatter(b []byte) (map[string]any, []byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var content map[string]any
+	if err := decoder.Decode(&content); err != nil {
+		return nil, nil, err
+	}
+	body := b[decoder.InputOffset():]
+	if bytes.HasPrefix(body, []byte("\r\n")) {
+		body = body[2:]
+	} else {
+		body = bytes.TrimPrefix(body, []byte("\n"))
+	}
+	return content, body, nil
+}
+
+// jsonValue converts a YAML node to a value that can be encoded as JSON.
+// Timestamps are kept as written, since they are parsed by the protobuf JSON format.
+func jsonValue(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return jsonValue(node.Content[0])
+	case yaml.MappingNode:
+		result := make(map[string]any, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			value, err := jsonValue(node.Content[i+1])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", node.Content[i].Value, err)
+			}
+			result[node.Content[i].Value] = value
+		}
+		return result, nil
+	case yaml.SequenceNode:
+		result := make([]any, 0, len(node.Content))
+		for _, element := range node.Content {
+			value, err := jsonValue(element)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, value)
+		}
+		return result, nil
+	case yaml.AliasNode:
+		return jsonValue(node.Alias)
+	default:
+		if node.ShortTag() == "!!timestamp" {
+			return node.Value, nil
+		}
+		var value any
+		if err := node.Decode(&value); err != nil {
+			return nil, err
+		}
+		return value, nil
+	}
+}
+
+// clearStyle clears the style of a YAML node and of its children.
+func clearStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearStyle(child)
+	}
+}
@@ -0,0 +1,178 @@
+package decapjson
+
+import (
+	"testing"
+
+	examplev1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/example/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUnmarshalFrontmatter(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		content  string
+		expected *examplev1.Article
+	}{
+		{
+			name: "YAML frontmatter",
+			content: "---\n" +
+				"name: down-the-rabbit-hole\n" +
+				"title: Down the Rabbit-Hole\n" +
+				"---\n" +
+				"Alice was beginning to get very tired.\n",
+			expected: &examplev1.Article{
+				Name:    "articles/down-the-rabbit-hole",
+				Title:   "Down the Rabbit-Hole",
+				Content: "Alice was beginning to get very tired.\n",
+			},
+		},
+		{
+			name:    "YAML frontmatter with CRLF line endings",
+			content: "---\r\ntitle: Title\r\n---\r\nBody\r\n",
+			expected: &examplev1.Article{
+				Title:   "Title",
+				Content: "Body\r\n",
+			},
+		},
+		{
+			name:     "empty YAML frontmatter",
+			content:  "---\n---\nBody",
+			expected: &examplev1.Article{Content: "Body"},
+		},
+		{
+			name:     "JSON frontmatter",
+			content:  "{\n  \"title\": \"Title\"\n}\nBody\n",
+			expected: &examplev1.Article{Title: "Title", Content: "Body\n"},
+		},
+		{
+			name:     "no frontmatter",
+			content:  "Body\n",
+			expected: &examplev1.Article{Content: "Body\n"},
+		},
+		{
+			name:     "no body",
+			content:  "---\ntitle: Title\n---\n",
+			expected: &examplev1.Article{Title: "Title"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual examplev1.Article
+			if err := UnmarshalFrontmatter([]byte(tt.content), &actual); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(tt.expected, &actual) {
+				t.Errorf("expected %v, got %v", tt.expected, &actual)
+			}
+		})
+	}
+}
+
+func TestUnmarshalFrontmatter_Timestamp(t *testing.T) {
+	var actual examplev1.KitchenSink
+	content := "---\ncreate_time: 2023-01-15T13:56:45.897Z\n---\n"
+	if err := UnmarshalFrontmatter([]byte(content), &actual); err != nil {
+		t.Fatal(err)
+	}
+	expected := &examplev1.KitchenSink{
+		CreateTime: timestamppb.New(mustParseTime(t, "2023-01-15T13:56:45.897Z")),
+	}
+	if !proto.Equal(expected, &actual) {
+		t.Errorf("expected %v, got %v", expected, &actual)
+	}
+}
+
+func TestUnmarshalFrontmatter_Error(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		content string
+	}{
+		{
+			name:    "unterminated YAML frontmatter",
+			content: "---\ntitle: Title\n",
+		},
+		{
+			name:    "YAML frontmatter that is not a mapping",
+			content: "---\n- title\n---\n",
+		},
+		{
+			name:    "TOML frontmatter",
+			content: "+++\ntitle = \"Title\"\n+++\n",
+		},
+		{
+			name:    "invalid JSON frontmatter",
+			content: "{\n  \"title\":\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual examplev1.Article
+			if err := UnmarshalFrontmatter([]byte(tt.content), &actual); err == nil {
+				t.Errorf("expected error, got %v", &actual)
+			}
+		})
+	}
+}
+
+func TestMarshalFrontmatter(t *testing.T) {
+	message := &examplev1.Article{
+		Name:    "articles/down-the-rabbit-hole",
+		Title:   "Down the Rabbit-Hole",
+		Content: "Alice was beginning to get very tired.\n",
+	}
+	data, err := MarshalFrontmatter(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "---\n" +
+		"name: down-the-rabbit-hole\n" +
+		"title: Down the Rabbit-Hole\n" +
+		"---\n" +
+		"Alice was beginning to get very tired.\n"
+	if string(data) != expected {
+		t.Errorf("expected %q, got %q", expected, data)
+	}
+}
+
+func TestMarshalFrontmatter_RoundTrip(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		message proto.Message
+	}{
+		{
+			name: "body",
+			message: &examplev1.Article{
+				Name:    "articles/example",
+				Title:   "2023-01-15",
+				Content: "---\nNot frontmatter.\n",
+			},
+		},
+		{
+			name:    "empty",
+			message: &examplev1.Article{},
+		},
+		{
+			name: "kitchen sink",
+			message: &examplev1.KitchenSink{
+				DisplayName: "true",
+				Int64Value:  42,
+				DoubleValue: 0.42,
+				CreateTime:  timestamppb.New(mustParseTime(t, "2023-01-15T13:56:45Z")),
+				Labels:      map[string]string{"a": "1"},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := MarshalFrontmatter(tt.message)
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual := tt.message.ProtoReflect().New().Interface()
+			if err := UnmarshalFrontmatter(data, actual); err != nil {
+				t.Fatalf("%v: %s", err, data)
+			}
+			if !proto.Equal(tt.message, actual) {
+				t.Errorf("expected %v, got %v: %s", tt.message, actual, data)
+			}
+		})
+	}
+}
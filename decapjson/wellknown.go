@@ -0,0 +1,148 @@
+package decapjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// timestampWithoutSecondsLayout is the layout of timestamps stored by a datetime widget without seconds.
+const timestampWithoutSecondsLayout = "2006-01-02T15:04Z07:00"
+
+// isWellKnownType reports whether a message is a well-known type stored in a different shape than
+// its protobuf JSON format.
+func isWellKnownType(message protoreflect.MessageDescriptor) bool {
+	switch message.FullName() {
+	case "google.protobuf.Struct",
+		"google.protobuf.Value",
+		"google.protobuf.ListValue",
+		"google.protobuf.Any",
+		"google.protobuf.FieldMask",
+		"google.protobuf.Timestamp",
+		"google.protobuf.Empty":
+		return true
+	}
+	return false
+}
+
+func fromDecapWellKnownType(message protoreflect.MessageDescriptor, value any) (any, error) {
+	switch message.FullName() {
+	case "google.protobuf.Struct",
+		"google.protobuf.Value",
+		"google.protobuf.ListValue",
+		"google.protobuf.Any":
+		// stored as a string of JSON code
+		code, ok := value.(string)
+		if !ok {
+			return value, nil
+		}
+		result, err := decode([]byte(code))
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON code: %w", err)
+		}
+		return result, nil
+	case "google.protobuf.FieldMask":
+		// stored as a list of paths
+		paths, ok := value.([]any)
+		if !ok {
+			return value, nil
+		}
+		result := make([]string, 0, len(paths))
+		for _, path := range paths {
+			path, ok := path.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid field mask path: %v", path)
+			}
+			result = append(result, snakeToCamelCase(path))
+		}
+		return strings.Join(result, ","), nil
+	case "google.protobuf.Timestamp":
+		// older entries may be stored without seconds
+		timestamp, ok := value.(string)
+		if !ok {
+			return value, nil
+		}
+		if _, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+			return timestamp, nil
+		}
+		t, err := time.Parse(timestampWithoutSecondsLayout, timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp: %s", timestamp)
+		}
+		return t.UTC().Format(time.RFC3339), nil
+	case "google.protobuf.Empty":
+		// stored as a boolean for its presence
+		present, ok := value.(bool)
+		if !ok {
+			return value, nil
+		}
+		if !present {
+			return nil, nil
+		}
+		return map[string]any{}, nil
+	}
+	return value, nil
+}
+
+func toDecapWellKnownType(message protoreflect.MessageDescriptor, value any) any {
+	switch message.FullName() {
+	case "google.protobuf.Struct",
+		"google.protobuf.Value",
+		"google.protobuf.ListValue",
+		"google.protobuf.Any":
+		code, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return value
+		}
+		return string(code)
+	case "google.protobuf.FieldMask":
+		paths, ok := value.(string)
+		if !ok {
+			return value
+		}
+		result := []any{}
+		for _, path := range strings.Split(paths, ",") {
+			if path != "" {
+				result = append(result, camelToSnakeCase(path))
+			}
+		}
+		return result
+	case "google.protobuf.Empty":
+		return true
+	}
+	return value
+}
+
+func snakeToCamelCase(s string) string {
+	var result strings.Builder
+	upper := false
+	for _, r := range s {
+		switch {
+		case r == '_':
+			upper = true
+		case upper && 'a' <= r && r <= 'z':
+			result.WriteRune(r - 'a' + 'A')
+			upper = false
+		default:
+			result.WriteRune(r)
+			upper = false
+		}
+	}
+	return result.String()
+}
+
+func camelToSnakeCase(s string) string {
+	var result strings.Builder
+	for _, r := range s {
+		if 'A' <= r && r <= 'Z' {
+			result.WriteByte('_')
+			result.WriteRune(r - 'A' + 'a')
+		} else {
+			result.WriteRune(r)
+		}
+	}
+	return result.String()
+}
@@ -0,0 +1,142 @@
+package decapjson
+
+import (
+	"testing"
+
+	examplev1 "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/example/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUnmarshal_WellKnownTypes(t *testing.T) {
+	runUnmarshalTests(t, []unmarshalTest{
+		{
+			name:     "cleared number",
+			content:  `{"double_value": "", "display_name": ""}`,
+			expected: &examplev1.KitchenSink{},
+		},
+		{
+			name:    "durations",
+			content: `{"duration": "3.5s", "durations": ["1s", "2s"]}`,
+			expected: &examplev1.KitchenSink{
+				Duration:  &durationpb.Duration{Seconds: 3, Nanos: 500000000},
+				Durations: []*durationpb.Duration{{Seconds: 1}, {Seconds: 2}},
+			},
+		},
+		{
+			name:    "struct as JSON code",
+			content: `{"metadata": "{\"key\": \"value\"}"}`,
+			expected: &examplev1.KitchenSink{
+				Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{"key": structpb.NewStringValue("value")}},
+			},
+		},
+		{
+			name:    "wrappers",
+			content: `{"nullable_int64_value": "", "nullable_string_value": ""}`,
+			expected: &examplev1.KitchenSink{
+				NullableStringValue: wrapperspb.String(""),
+			},
+		},
+		{
+			name:    "field mask as list of paths",
+			content: `{"field_mask": ["display_name", "create_time"]}`,
+			expected: &examplev1.KitchenSink{
+				FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name", "create_time"}},
+			},
+		},
+		{
+			name:    "legacy timestamp without seconds",
+			content: `{"create_time": "2023-01-15T14:58+01:00"}`,
+			expected: &examplev1.KitchenSink{
+				CreateTime: timestamppb.New(mustParseTime(t, "2023-01-15T13:58:00Z")),
+			},
+		},
+		{
+			name:    "timestamp with seconds",
+			content: `{"create_time": "2023-01-15T13:58:30.5Z"}`,
+			expected: &examplev1.KitchenSink{
+				CreateTime: timestamppb.New(mustParseTime(t, "2023-01-15T13:58:30.5Z")),
+			},
+		},
+		{
+			name:    "oneof empty message as presence",
+			content: `{"example_oneof": [{"example_oneof": "oneof_empty", "oneof_empty": true}]}`,
+			expected: &examplev1.KitchenSink{
+				ExampleOneof: &examplev1.KitchenSink_OneofEmpty{OneofEmpty: &emptypb.Empty{}},
+			},
+		},
+		{
+			name:     "oneof empty message without presence",
+			content:  `{"example_oneof": [{"example_oneof": "oneof_empty", "oneof_empty": false}]}`,
+			expected: &examplev1.KitchenSink{},
+		},
+	})
+}
+
+func TestUnmarshal_WellKnownTypesError(t *testing.T) {
+	runUnmarshalErrorTests(t, []unmarshalErrorTest{
+		{
+			name:    "invalid JSON code",
+			content: `{"metadata": "{"}`,
+		},
+		{
+			name:    "JSON code that is not an object",
+			content: `{"metadata": "[]"}`,
+		},
+		{
+			name:    "invalid timestamp",
+			content: `{"create_time": "2023-01-15"}`,
+		},
+		{
+			name:    "legacy timestamp without time zone",
+			content: `{"create_time": "2023-01-15T14:58"}`,
+		},
+	})
+}
+
+func TestMarshal_WellKnownTypes(t *testing.T) {
+	runMarshalTests(t, []marshalTest{
+		{
+			name: "struct as JSON code and field mask as list of paths",
+			message: &examplev1.KitchenSink{
+				Metadata:  &structpb.Struct{Fields: map[string]*structpb.Value{"key": structpb.NewBoolValue(true)}},
+				FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+			},
+			expected: `{"metadata": "{\n  \"key\": true\n}", "field_mask": ["display_name"]}`,
+		},
+		{
+			name: "empty message as presence",
+			message: &examplev1.KitchenSink{
+				ExampleOneof: &examplev1.KitchenSink_OneofEmpty{OneofEmpty: &emptypb.Empty{}},
+			},
+			expected: `{"example_oneof": [{"example_oneof": "oneof_empty", "oneof_empty": true}]}`,
+		},
+	})
+}
+
+func TestMarshal_WellKnownTypesRoundTrip(t *testing.T) {
+	runRoundTripTests(t, []roundTripTest{
+		{
+			name: "well-known types",
+			message: &examplev1.KitchenSink{
+				CreateTime:          timestamppb.New(mustParseTime(t, "2023-01-15T13:58:00Z")),
+				Duration:            durationpb.New(1500000000),
+				Durations:           []*durationpb.Duration{durationpb.New(0)},
+				Metadata:            &structpb.Struct{Fields: map[string]*structpb.Value{"n": structpb.NewNumberValue(1)}},
+				NullableInt64Value:  wrapperspb.Int64(42),
+				NullableStringValue: wrapperspb.String(""),
+				FieldMask:           &fieldmaskpb.FieldMask{Paths: []string{"display_name", "time_of_day.hours"}},
+			},
+		},
+		{
+			name: "oneof empty message",
+			message: &examplev1.KitchenSink{
+				ExampleOneof: &examplev1.KitchenSink_OneofEmpty{OneofEmpty: &emptypb.Empty{}},
+			},
+		},
+	})
+}
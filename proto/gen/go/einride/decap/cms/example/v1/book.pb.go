@@ -111,16 +111,16 @@ var File_einride_decap_cms_example_v1_book_proto protoreflect.FileDescriptor
 
 const file_einride_decap_cms_example_v1_book_proto_rawDesc = "" +
 	"\n" +
-	"'einride/decap/cms/example/v1/book.proto\x12\x1ceinride.decap.cms.example.v1\x1a&einride/decap/cms/v1/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaf\x02\n" +
+	"'einride/decap/cms/example/v1/book.proto\x12\x1ceinride.decap.cms.example.v1\x1a&einride/decap/cms/v1/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xab\x02\n" +
 	"\x04Book\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12A\n" +
 	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x04\xe2A\x01\x03R\n" +
 	"createTime\x12\x1c\n" +
 	"\x06author\x18\x03 \x01(\tB\x04\xe2A\x01\x02R\x06author\x12\x1a\n" +
 	"\x05title\x18\x04 \x01(\tB\x04\xe2A\x01\x02R\x05title\x12\x12\n" +
-	"\x04read\x18\x05 \x01(\bR\x04read:\x81\x01\xeaA3\n" +
-	"#decap-cms-example.einride.tech/Book\x12\fbooks/{book}\xda\xf6\xf1\x97\x02E\n" +
-	"\x05books\x12\x04name\x1a\x05Books\"\x04Book*\x05Books2\rexample/books8\x01B\x04jsonJ\t{{title}}R\x00B\x9a\x02\n" +
+	"\x04read\x18\x05 \x01(\bR\x04read:~\xeaA3\n" +
+	"#decap-cms-example.einride.tech/Book\x12\fbooks/{book}\xda\xf6\xf1\x97\x02B\n" +
+	"\x05books\x12\x04name\x1a\x05Books\"\x04Book*\x05Books2\rexample/books8\x01J\t{{title}}R\x00\xf0\x01\x03B\x9a\x02\n" +
 	" com.einride.decap.cms.example.v1B\tBookProtoP\x01ZVgo.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/example/v1;examplev1\xa2\x02\x04EDCE\xaa\x02\x1cEinride.Decap.Cms.Example.V1\xca\x02\x1cEinride\\Decap\\Cms\\Example\\V1\xe2\x02(Einride\\Decap\\Cms\\Example\\V1\\GPBMetadata\xea\x02 Einride::Decap::Cms::Example::V1b\x06proto3"
 
 var (
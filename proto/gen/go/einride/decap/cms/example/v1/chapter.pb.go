@@ -0,0 +1,151 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: einride/decap/cms/example/v1/chapter.proto
+
+package examplev1
+
+import (
+	_ "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A chapter of a book.
+type Chapter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the chapter.
+	// Chapter names have the form `books/{book_id}/chapters/{chapter_id}`.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The title of the chapter.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The number of pages in the chapter.
+	PageCount     int32 `protobuf:"varint,3,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Chapter) Reset() {
+	*x = Chapter{}
+	mi := &file_einride_decap_cms_example_v1_chapter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chapter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chapter) ProtoMessage() {}
+
+func (x *Chapter) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_decap_cms_example_v1_chapter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chapter.ProtoReflect.Descriptor instead.
+func (*Chapter) Descriptor() ([]byte, []int) {
+	return file_einride_decap_cms_example_v1_chapter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Chapter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Chapter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Chapter) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+var File_einride_decap_cms_example_v1_chapter_proto protoreflect.FileDescriptor
+
+const file_einride_decap_cms_example_v1_chapter_proto_rawDesc = "" +
+	"\n" +
+	"*einride/decap/cms/example/v1/chapter.proto\x12\x1ceinride.decap.cms.example.v1\x1a&einride/decap/cms/v1/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\"\x94\x02\n" +
+	"\aChapter\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\x05title\x18\x02 \x01(\tB\x04\xe2A\x01\x02R\x05title\x12\x1d\n" +
+	"\n" +
+	"page_count\x18\x03 \x01(\x05R\tpageCount:\xb9\x01\xeaAI\n" +
+	"&decap-cms-example.einride.tech/Chapter\x12\x1fbooks/{book}/chapters/{chapter}\xda\xf6\xf1\x97\x02g\n" +
+	"\bchapters\x12\x04name\x1a\bChapters\"\aChapter*\x11Chapters of books2\x10example/chapters8\x01J\t{{title}}R\x00j\v\x12\t{{title}}\xf0\x01\x03B\x9d\x02\n" +
+	" com.einride.decap.cms.example.v1B\fChapterProtoP\x01ZVgo.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/example/v1;examplev1\xa2\x02\x04EDCE\xaa\x02\x1cEinride.Decap.Cms.Example.V1\xca\x02\x1cEinride\\Decap\\Cms\\Example\\V1\xe2\x02(Einride\\Decap\\Cms\\Example\\V1\\GPBMetadata\xea\x02 Einride::Decap::Cms::Example::V1b\x06proto3"
+
+var (
+	file_einride_decap_cms_example_v1_chapter_proto_rawDescOnce sync.Once
+	file_einride_decap_cms_example_v1_chapter_proto_rawDescData []byte
+)
+
+func file_einride_decap_cms_example_v1_chapter_proto_rawDescGZIP() []byte {
+	file_einride_decap_cms_example_v1_chapter_proto_rawDescOnce.Do(func() {
+		file_einride_decap_cms_example_v1_chapter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_einride_decap_cms_example_v1_chapter_proto_rawDesc), len(file_einride_decap_cms_example_v1_chapter_proto_rawDesc)))
+	})
+	return file_einride_decap_cms_example_v1_chapter_proto_rawDescData
+}
+
+var file_einride_decap_cms_example_v1_chapter_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_einride_decap_cms_example_v1_chapter_proto_goTypes = []any{
+	(*Chapter)(nil), // 0: einride.decap.cms.example.v1.Chapter
+}
+var file_einride_decap_cms_example_v1_chapter_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_einride_decap_cms_example_v1_chapter_proto_init() }
+func file_einride_decap_cms_example_v1_chapter_proto_init() {
+	if File_einride_decap_cms_example_v1_chapter_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_einride_decap_cms_example_v1_chapter_proto_rawDesc), len(file_einride_decap_cms_example_v1_chapter_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_einride_decap_cms_example_v1_chapter_proto_goTypes,
+		DependencyIndexes: file_einride_decap_cms_example_v1_chapter_proto_depIdxs,
+		MessageInfos:      file_einride_decap_cms_example_v1_chapter_proto_msgTypes,
+	}.Build()
+	File_einride_decap_cms_example_v1_chapter_proto = out.File
+	file_einride_decap_cms_example_v1_chapter_proto_goTypes = nil
+	file_einride_decap_cms_example_v1_chapter_proto_depIdxs = nil
+}
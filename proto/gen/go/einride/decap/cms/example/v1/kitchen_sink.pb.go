@@ -7,11 +7,23 @@
 package examplev1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	_ "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	color "google.golang.org/genproto/googleapis/type/color"
+	date "google.golang.org/genproto/googleapis/type/date"
+	latlng "google.golang.org/genproto/googleapis/type/latlng"
+	money "google.golang.org/genproto/googleapis/type/money"
+	postaladdress "google.golang.org/genproto/googleapis/type/postaladdress"
+	timeofday "google.golang.org/genproto/googleapis/type/timeofday"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -34,6 +46,10 @@ const (
 	KitchenSink_ONE KitchenSink_ExampleEnum = 1
 	// Two.
 	KitchenSink_TWO KitchenSink_ExampleEnum = 2
+	// Three, no longer in use.
+	//
+	// Deprecated: Marked as deprecated in einride/decap/cms/example/v1/kitchen_sink.proto.
+	KitchenSink_THREE KitchenSink_ExampleEnum = 3
 )
 
 // Enum value maps for KitchenSink_ExampleEnum.
@@ -42,11 +58,13 @@ var (
 		0: "EXAMPLE_ENUM_UNSPECIFIED",
 		1: "ONE",
 		2: "TWO",
+		3: "THREE",
 	}
 	KitchenSink_ExampleEnum_value = map[string]int32{
 		"EXAMPLE_ENUM_UNSPECIFIED": 0,
 		"ONE":                      1,
 		"TWO":                      2,
+		"THREE":                    3,
 	}
 )
 
@@ -103,7 +121,89 @@ type KitchenSink struct {
 	// A value with relation to another entity
 	Book string `protobuf:"bytes,11,opt,name=book,proto3" json:"book,omitempty"`
 	// A nested list of some specs to show usage of a list widget.
-	Specs         []*KitchenSink_SomeSpec `protobuf:"bytes,12,rep,name=specs,proto3" json:"specs,omitempty"`
+	Specs []*KitchenSink_SomeSpec `protobuf:"bytes,12,rep,name=specs,proto3" json:"specs,omitempty"`
+	// A uint32 value.
+	Uint32Value uint32 `protobuf:"varint,13,opt,name=uint32_value,json=uint32Value,proto3" json:"uint32_value,omitempty"`
+	// A sint32 value.
+	Sint32Value int32 `protobuf:"zigzag32,14,opt,name=sint32_value,json=sint32Value,proto3" json:"sint32_value,omitempty"`
+	// A fixed64 value.
+	Fixed64Value uint64 `protobuf:"fixed64,15,opt,name=fixed64_value,json=fixed64Value,proto3" json:"fixed64_value,omitempty"`
+	// A list of double values.
+	DoubleValues []float64 `protobuf:"fixed64,16,rep,packed,name=double_values,json=doubleValues,proto3" json:"double_values,omitempty"`
+	// A list of int64 values.
+	Int64Values []int64 `protobuf:"varint,17,rep,packed,name=int64_values,json=int64Values,proto3" json:"int64_values,omitempty"`
+	// A list of bool values.
+	BoolValues []bool `protobuf:"varint,18,rep,packed,name=bool_values,json=boolValues,proto3" json:"bool_values,omitempty"`
+	// A list of enum values.
+	ExampleEnums []KitchenSink_ExampleEnum `protobuf:"varint,19,rep,packed,name=example_enums,json=exampleEnums,proto3,enum=einride.decap.cms.example.v1.KitchenSink_ExampleEnum" json:"example_enums,omitempty"`
+	// A map of labels.
+	Labels map[string]string `protobuf:"bytes,20,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// A map of specs, keyed by ID.
+	SpecMap map[int32]*KitchenSink_SomeSpec `protobuf:"bytes,21,rep,name=spec_map,json=specMap,proto3" json:"spec_map,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// A duration value.
+	Duration *durationpb.Duration `protobuf:"bytes,22,opt,name=duration,proto3" json:"duration,omitempty"`
+	// A list of duration values.
+	Durations []*durationpb.Duration `protobuf:"bytes,23,rep,name=durations,proto3" json:"durations,omitempty"`
+	// A struct value.
+	Metadata *structpb.Struct `protobuf:"bytes,24,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// A nullable int64 value.
+	NullableInt64Value *wrapperspb.Int64Value `protobuf:"bytes,25,opt,name=nullable_int64_value,json=nullableInt64Value,proto3" json:"nullable_int64_value,omitempty"`
+	// A nullable string value.
+	NullableStringValue *wrapperspb.StringValue `protobuf:"bytes,26,opt,name=nullable_string_value,json=nullableStringValue,proto3" json:"nullable_string_value,omitempty"`
+	// A field mask value.
+	FieldMask *fieldmaskpb.FieldMask `protobuf:"bytes,27,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	// A date value.
+	Date *date.Date `protobuf:"bytes,28,opt,name=date,proto3" json:"date,omitempty"`
+	// A time of day value.
+	TimeOfDay *timeofday.TimeOfDay `protobuf:"bytes,29,opt,name=time_of_day,json=timeOfDay,proto3" json:"time_of_day,omitempty"`
+	// A location value.
+	Location *latlng.LatLng `protobuf:"bytes,30,opt,name=location,proto3" json:"location,omitempty"`
+	// A money value.
+	Price *money.Money `protobuf:"bytes,31,opt,name=price,proto3" json:"price,omitempty"`
+	// A color value.
+	Color *color.Color `protobuf:"bytes,32,opt,name=color,proto3" json:"color,omitempty"`
+	// A postal address value.
+	Address *postaladdress.PostalAddress `protobuf:"bytes,33,opt,name=address,proto3" json:"address,omitempty"`
+	// An example oneof.
+	//
+	// Types that are valid to be assigned to ExampleOneof:
+	//
+	//	*KitchenSink_OneofString
+	//	*KitchenSink_OneofSpec
+	//	*KitchenSink_OneofEmpty
+	ExampleOneof isKitchenSink_ExampleOneof `protobuf_oneof:"example_oneof"`
+	// A list of values with relations to another entity.
+	RelatedBooks []string `protobuf:"bytes,36,rep,name=related_books,json=relatedBooks,proto3" json:"related_books,omitempty"`
+	// A value with a markdown widget.
+	MarkdownValue string `protobuf:"bytes,37,opt,name=markdown_value,json=markdownValue,proto3" json:"markdown_value,omitempty"`
+	// A value with a hidden widget.
+	HiddenValue string `protobuf:"bytes,38,opt,name=hidden_value,json=hiddenValue,proto3" json:"hidden_value,omitempty"`
+	// A value with a code widget.
+	CodeValue string `protobuf:"bytes,39,opt,name=code_value,json=codeValue,proto3" json:"code_value,omitempty"`
+	// A cover image, inferred as an image widget from the field name.
+	CoverImageUri string `protobuf:"bytes,40,opt,name=cover_image_uri,json=coverImageUri,proto3" json:"cover_image_uri,omitempty"`
+	// Gallery images, inferred as an image widget allowing multiple images.
+	GalleryImageUris []string `protobuf:"bytes,41,rep,name=gallery_image_uris,json=galleryImageUris,proto3" json:"gallery_image_uris,omitempty"`
+	// A value with a file widget.
+	Attachment string `protobuf:"bytes,42,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	// Tags, with new tags added to the top of the list.
+	Tags []string `protobuf:"bytes,43,rep,name=tags,proto3" json:"tags,omitempty"`
+	// A rating, with a range from protovalidate rules.
+	Rating int32 `protobuf:"varint,44,opt,name=rating,proto3" json:"rating,omitempty"`
+	// A ratio, with an exclusive upper bound from protovalidate rules.
+	Ratio float64 `protobuf:"fixed64,45,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	// A stock keeping unit, with a pattern from protovalidate rules.
+	Sku string `protobuf:"bytes,46,opt,name=sku,proto3" json:"sku,omitempty"`
+	// A nickname, with a length from protovalidate rules.
+	Nickname string `protobuf:"bytes,47,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// Aliases, with a maximum number of items from protovalidate rules.
+	Aliases []string `protobuf:"bytes,48,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// A value with a number widget stepping by halves.
+	HalfSteps float64 `protobuf:"fixed64,49,opt,name=half_steps,json=halfSteps,proto3" json:"half_steps,omitempty"`
+	// The kind of the kitchen sink, stored with a hidden widget from the protovalidate constant.
+	Kind string `protobuf:"bytes,50,opt,name=kind,proto3" json:"kind,omitempty"`
+	// A value with a boolean widget enabled by default.
+	Enabled       bool `protobuf:"varint,51,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -222,6 +322,324 @@ func (x *KitchenSink) GetSpecs() []*KitchenSink_SomeSpec {
 	return nil
 }
 
+func (x *KitchenSink) GetUint32Value() uint32 {
+	if x != nil {
+		return x.Uint32Value
+	}
+	return 0
+}
+
+func (x *KitchenSink) GetSint32Value() int32 {
+	if x != nil {
+		return x.Sint32Value
+	}
+	return 0
+}
+
+func (x *KitchenSink) GetFixed64Value() uint64 {
+	if x != nil {
+		return x.Fixed64Value
+	}
+	return 0
+}
+
+func (x *KitchenSink) GetDoubleValues() []float64 {
+	if x != nil {
+		return x.DoubleValues
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetInt64Values() []int64 {
+	if x != nil {
+		return x.Int64Values
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetBoolValues() []bool {
+	if x != nil {
+		return x.BoolValues
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetExampleEnums() []KitchenSink_ExampleEnum {
+	if x != nil {
+		return x.ExampleEnums
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetSpecMap() map[int32]*KitchenSink_SomeSpec {
+	if x != nil {
+		return x.SpecMap
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetDurations() []*durationpb.Duration {
+	if x != nil {
+		return x.Durations
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetNullableInt64Value() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.NullableInt64Value
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetNullableStringValue() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NullableStringValue
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetDate() *date.Date {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetTimeOfDay() *timeofday.TimeOfDay {
+	if x != nil {
+		return x.TimeOfDay
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetLocation() *latlng.LatLng {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetColor() *color.Color {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetAddress() *postaladdress.PostalAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetExampleOneof() isKitchenSink_ExampleOneof {
+	if x != nil {
+		return x.ExampleOneof
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetOneofString() string {
+	if x != nil {
+		if x, ok := x.ExampleOneof.(*KitchenSink_OneofString); ok {
+			return x.OneofString
+		}
+	}
+	return ""
+}
+
+func (x *KitchenSink) GetOneofSpec() *KitchenSink_SomeSpec {
+	if x != nil {
+		if x, ok := x.ExampleOneof.(*KitchenSink_OneofSpec); ok {
+			return x.OneofSpec
+		}
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetOneofEmpty() *emptypb.Empty {
+	if x != nil {
+		if x, ok := x.ExampleOneof.(*KitchenSink_OneofEmpty); ok {
+			return x.OneofEmpty
+		}
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetRelatedBooks() []string {
+	if x != nil {
+		return x.RelatedBooks
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetMarkdownValue() string {
+	if x != nil {
+		return x.MarkdownValue
+	}
+	return ""
+}
+
+func (x *KitchenSink) GetHiddenValue() string {
+	if x != nil {
+		return x.HiddenValue
+	}
+	return ""
+}
+
+func (x *KitchenSink) GetCodeValue() string {
+	if x != nil {
+		return x.CodeValue
+	}
+	return ""
+}
+
+func (x *KitchenSink) GetCoverImageUri() string {
+	if x != nil {
+		return x.CoverImageUri
+	}
+	return ""
+}
+
+func (x *KitchenSink) GetGalleryImageUris() []string {
+	if x != nil {
+		return x.GalleryImageUris
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetAttachment() string {
+	if x != nil {
+		return x.Attachment
+	}
+	return ""
+}
+
+func (x *KitchenSink) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *KitchenSink) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+func (x *KitchenSink) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *KitchenSink) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *KitchenSink) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetHalfSteps() float64 {
+	if x != nil {
+		return x.HalfSteps
+	}
+	return 0
+}
+
+func (x *KitchenSink) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *KitchenSink) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type isKitchenSink_ExampleOneof interface {
+	isKitchenSink_ExampleOneof()
+}
+
+type KitchenSink_OneofString struct {
+	// A string member of the oneof.
+	OneofString string `protobuf:"bytes,34,opt,name=oneof_string,json=oneofString,proto3,oneof"`
+}
+
+type KitchenSink_OneofSpec struct {
+	// A message member of the oneof.
+	OneofSpec *KitchenSink_SomeSpec `protobuf:"bytes,35,opt,name=oneof_spec,json=oneofSpec,proto3,oneof"`
+}
+
+type KitchenSink_OneofEmpty struct {
+	// An empty member of the oneof.
+	OneofEmpty *emptypb.Empty `protobuf:"bytes,52,opt,name=oneof_empty,json=oneofEmpty,proto3,oneof"`
+}
+
+func (*KitchenSink_OneofString) isKitchenSink_ExampleOneof() {}
+
+func (*KitchenSink_OneofSpec) isKitchenSink_ExampleOneof() {}
+
+func (*KitchenSink_OneofEmpty) isKitchenSink_ExampleOneof() {}
+
 // SomeSpec is a dummy message struct holds some dummy fields.
 type KitchenSink_SomeSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -233,7 +651,7 @@ type KitchenSink_SomeSpec struct {
 
 func (x *KitchenSink_SomeSpec) Reset() {
 	*x = KitchenSink_SomeSpec{}
-	mi := &file_einride_decap_cms_example_v1_kitchen_sink_proto_msgTypes[1]
+	mi := &file_einride_decap_cms_example_v1_kitchen_sink_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -245,7 +663,7 @@ func (x *KitchenSink_SomeSpec) String() string {
 func (*KitchenSink_SomeSpec) ProtoMessage() {}
 
 func (x *KitchenSink_SomeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_example_v1_kitchen_sink_proto_msgTypes[1]
+	mi := &file_einride_decap_cms_example_v1_kitchen_sink_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,7 +676,7 @@ func (x *KitchenSink_SomeSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KitchenSink_SomeSpec.ProtoReflect.Descriptor instead.
 func (*KitchenSink_SomeSpec) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_example_v1_kitchen_sink_proto_rawDescGZIP(), []int{0, 0}
+	return file_einride_decap_cms_example_v1_kitchen_sink_proto_rawDescGZIP(), []int{0, 2}
 }
 
 func (x *KitchenSink_SomeSpec) GetName() string {
@@ -279,10 +697,10 @@ var File_einride_decap_cms_example_v1_kitchen_sink_proto protoreflect.FileDescri
 
 const file_einride_decap_cms_example_v1_kitchen_sink_proto_rawDesc = "" +
 	"\n" +
-	"/einride/decap/cms/example/v1/kitchen_sink.proto\x12\x1ceinride.decap.cms.example.v1\x1a&einride/decap/cms/v1/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc6\t\n" +
-	"\vKitchenSink\x12V\n" +
-	"\x04name\x18\x01 \x01(\tBB\xaa\xf6\xa1\xf3\a<\":\xaa\x017\n" +
-	"\x06string\x12\x18default: 'kitchenSinks/'\x12\x06outer:\x12\v  inner: 42R\x04name\x12A\n" +
+	"/einride/decap/cms/example/v1/kitchen_sink.proto\x12\x1ceinride.decap.cms.example.v1\x1a\x1bbuf/validate/validate.proto\x1a&einride/decap/cms/v1/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x17google/type/color.proto\x1a\x16google/type/date.proto\x1a\x18google/type/latlng.proto\x1a\x17google/type/money.proto\x1a google/type/postal_address.proto\x1a\x1bgoogle/type/timeofday.proto\"\x88\x1c\n" +
+	"\vKitchenSink\x12Y\n" +
+	"\x04name\x18\x01 \x01(\tBE\xaa\xf6\xa1\xf3\a?\"=\xaa\x01:\n" +
+	"\x06string\x12\x1bdefault: 'new-kitchen-sink'\x12\x06outer:\x12\v  inner: 42R\x04name\x12A\n" +
 	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x04\xe2A\x01\x03R\n" +
 	"createTime\x12&\n" +
 	"\vrevision_id\x18\x03 \x01(\tB\x05\xe2A\x02\x05\x03R\n" +
@@ -294,24 +712,93 @@ const file_einride_decap_cms_example_v1_kitchen_sink_proto_rawDesc = "" +
 	"\vfloat_value\x18\b \x01(\x02R\n" +
 	"floatValue\x12\x1f\n" +
 	"\vint64_value\x18\t \x01(\x03R\n" +
-	"int64Value\x12I\n" +
+	"int64Value\x12W\n" +
 	"\fcustom_value\x18\n" +
-	" \x01(\tB&\xaa\xf6\xa1\xf3\a \"\x1e\xaa\x01\x1b\n" +
-	"\x04test\x12\x06outer:\x12\v  inner: 42R\vcustomValue\x12\x97\x01\n" +
-	"\x04book\x18\v \x01(\tB\x82\x01\xe2A\x01\x02\xfaA%\n" +
-	"#decap-cms-example.einride.tech/Book\xaa\xf6\xa1\xf3\aP\"N\x8a\x01K\n" +
-	"\x05books\x12\x04name\x1a\x04name\x1a\x05title\"\x05title2(\n" +
+	" \x01(\tB4\xaa\xf6\xa1\xf3\a.\",\xaa\x01)\n" +
+	"\x04test\x1a!\n" +
+	"\x1f\n" +
+	"\x05outer\x12\x16*\x14\n" +
+	"\x12\n" +
+	"\x05inner\x12\t\x11\x00\x00\x00\x00\x00\x00E@R\vcustomValue\x12u\n" +
+	"\x04book\x18\v \x01(\tBa\xe2A\x01\x02\xfaA%\n" +
+	"#decap-cms-example.einride.tech/Book\xaa\xf6\xa1\xf3\a/\"-\x8a\x01*2(\n" +
 	"\x06author\x12\rLewis Carroll\x12\x0fMarcus AureliusR\x04book\x12\x7f\n" +
-	"\x05specs\x18\f \x03(\v22.einride.decap.cms.example.v1.KitchenSink.SomeSpecB5\xaa\xf6\xa1\xf3\a/\"-b+\x1a){{fields.name}} - count: {{fields.count}}R\x05specs\x1a4\n" +
+	"\x05specs\x18\f \x03(\v22.einride.decap.cms.example.v1.KitchenSink.SomeSpecB5\xaa\xf6\xa1\xf3\a/\"-b+\x1a){{fields.name}} - count: {{fields.count}}R\x05specs\x12!\n" +
+	"\fuint32_value\x18\r \x01(\rR\vuint32Value\x12!\n" +
+	"\fsint32_value\x18\x0e \x01(\x11R\vsint32Value\x12#\n" +
+	"\rfixed64_value\x18\x0f \x01(\x06R\ffixed64Value\x12#\n" +
+	"\rdouble_values\x18\x10 \x03(\x01R\fdoubleValues\x12!\n" +
+	"\fint64_values\x18\x11 \x03(\x03R\vint64Values\x12\x1f\n" +
+	"\vbool_values\x18\x12 \x03(\bR\n" +
+	"boolValues\x12s\n" +
+	"\rexample_enums\x18\x13 \x03(\x0e25.einride.decap.cms.example.v1.KitchenSink.ExampleEnumB\x17\xaa\xf6\xa1\xf3\a\x11\"\x0f\x92\x01\f\n" +
+	"\x03ONE\n" +
+	"\x03TWO(\x02R\fexampleEnums\x12M\n" +
+	"\x06labels\x18\x14 \x03(\v25.einride.decap.cms.example.v1.KitchenSink.LabelsEntryR\x06labels\x12Q\n" +
+	"\bspec_map\x18\x15 \x03(\v26.einride.decap.cms.example.v1.KitchenSink.SpecMapEntryR\aspecMap\x125\n" +
+	"\bduration\x18\x16 \x01(\v2\x19.google.protobuf.DurationR\bduration\x127\n" +
+	"\tdurations\x18\x17 \x03(\v2\x19.google.protobuf.DurationR\tdurations\x123\n" +
+	"\bmetadata\x18\x18 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12M\n" +
+	"\x14nullable_int64_value\x18\x19 \x01(\v2\x1b.google.protobuf.Int64ValueR\x12nullableInt64Value\x12P\n" +
+	"\x15nullable_string_value\x18\x1a \x01(\v2\x1c.google.protobuf.StringValueR\x13nullableStringValue\x129\n" +
+	"\n" +
+	"field_mask\x18\x1b \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\x12%\n" +
+	"\x04date\x18\x1c \x01(\v2\x11.google.type.DateR\x04date\x126\n" +
+	"\vtime_of_day\x18\x1d \x01(\v2\x16.google.type.TimeOfDayR\ttimeOfDay\x12/\n" +
+	"\blocation\x18\x1e \x01(\v2\x13.google.type.LatLngR\blocation\x12(\n" +
+	"\x05price\x18\x1f \x01(\v2\x12.google.type.MoneyR\x05price\x12(\n" +
+	"\x05color\x18  \x01(\v2\x12.google.type.ColorR\x05color\x124\n" +
+	"\aaddress\x18! \x01(\v2\x1a.google.type.PostalAddressR\aaddress\x12#\n" +
+	"\foneof_string\x18\" \x01(\tH\x00R\voneofString\x12S\n" +
+	"\n" +
+	"oneof_spec\x18# \x01(\v22.einride.decap.cms.example.v1.KitchenSink.SomeSpecH\x00R\toneofSpec\x129\n" +
+	"\voneof_empty\x184 \x01(\v2\x16.google.protobuf.EmptyH\x00R\n" +
+	"oneofEmpty\x12M\n" +
+	"\rrelated_books\x18$ \x03(\tB(\xfaA%\n" +
+	"#decap-cms-example.einride.tech/BookR\frelatedBooks\x123\n" +
+	"\x0emarkdown_value\x18% \x01(\tB\f\xaa\xf6\xa1\xf3\a\x06\"\x04r\x02\x10\x01R\rmarkdownValue\x125\n" +
+	"\fhidden_value\x18& \x01(\tB\x12\xaa\xf6\xa1\xf3\a\f\"\n" +
+	"R\b\x12\x06hiddenR\vhiddenValue\x12/\n" +
+	"\n" +
+	"code_value\x18' \x01(\tB\x10\xaa\xf6\xa1\xf3\a\n" +
+	"\"\b*\x06\n" +
+	"\x02go \x01R\tcodeValue\x12&\n" +
+	"\x0fcover_image_uri\x18( \x01(\tR\rcoverImageUri\x12,\n" +
+	"\x12gallery_image_uris\x18) \x03(\tR\x10galleryImageUris\x12\x80\x01\n" +
+	"\n" +
+	"attachment\x18* \x01(\tB`\xaa\xf6\xa1\xf3\aZ\"XJV\x12 \x10\x00\x1a\x1c\n" +
+	"\x1a\n" +
+	"\rmax_file_size\x12\t\x11\x00\x00\x00\x00\x00\x000A\x1a\x1c/example/uploads/attachments\"\x14/uploads/attachmentsR\n" +
+	"attachment\x12'\n" +
+	"\x04tags\x18+ \x03(\tB\x13\xaa\xf6\xa1\xf3\a\r\"\vb\t*\x03TAG8\x05H\x01R\x04tags\x12!\n" +
+	"\x06rating\x18, \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x05(\x01R\x06rating\x12-\n" +
+	"\x05ratio\x18- \x01(\x01B\x17\xbaH\x14\x12\x12\x11\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x05ratio\x12,\n" +
+	"\x03sku\x18. \x01(\tB\x1a\xbaH\x17r\x152\x13^[A-Z]{3}-[0-9]{4}$R\x03sku\x12%\n" +
+	"\bnickname\x18/ \x01(\tB\t\xbaH\x06r\x04\x10\x02\x18\x14R\bnickname\x12\"\n" +
+	"\aaliases\x180 \x03(\tB\b\xbaH\x05\x92\x01\x02\x10\x03R\aaliases\x124\n" +
+	"\n" +
+	"half_steps\x181 \x01(\x01B\x15\xaa\xf6\xa1\xf3\a\x0f\"\rz\v\x10\x02)\x00\x00\x00\x00\x00\x00\xe0?R\thalfSteps\x12'\n" +
+	"\x04kind\x182 \x01(\tB\x13\xbaH\x10r\x0e\n" +
+	"\fkitchen-sinkR\x04kind\x12&\n" +
+	"\aenabled\x183 \x01(\bB\f\xaa\xf6\xa1\xf3\a\x06\"\x04\"\x02\b\x01R\aenabled\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1an\n" +
+	"\fSpecMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12H\n" +
+	"\x05value\x18\x02 \x01(\v22.einride.decap.cms.example.v1.KitchenSink.SomeSpecR\x05value:\x028\x01\x1a4\n" +
 	"\bSomeSpec\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count\"=\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"]\n" +
 	"\vExampleEnum\x12\x1c\n" +
 	"\x18EXAMPLE_ENUM_UNSPECIFIED\x10\x00\x12\a\n" +
-	"\x03ONE\x10\x01\x12\a\n" +
-	"\x03TWO\x10\x02:\xd6\x01\xeaAI\n" +
-	"*decap-cms-example.einride.tech/KitchenSink\x12\x1bkitchenSinks/{kitchen_sink}\xda\xf6\xf1\x97\x02\x83\x01\n" +
-	"\rkitchen_sinks\x12\x04name\x1a\rKitchen Sinks\"\fKitchen Sink*\x1dKitchen sink example messages2\x14example/kitchenSinks8\x01B\x04jsonJ\x10{{display_name}}R\x00B\xa1\x02\n" +
+	"\x03ONE\x10\x01\x12\x18\n" +
+	"\x03TWO\x10\x02\x1a\x0f\xe2\xec\x96\xf7\x02\t\n" +
+	"\aTwo (2)\x12\r\n" +
+	"\x05THREE\x10\x03\x1a\x02\b\x01:\xd3\x01\xeaAI\n" +
+	"*decap-cms-example.einride.tech/KitchenSink\x12\x1bkitchenSinks/{kitchen_sink}\xda\xf6\xf1\x97\x02\x80\x01\n" +
+	"\rkitchen_sinks\x12\x04name\x1a\rKitchen Sinks\"\fKitchen Sink*\x1dKitchen sink example messages2\x14example/kitchenSinks8\x01J\x10{{display_name}}R\x00\xf0\x01\x03B\x0f\n" +
+	"\rexample_oneofB\xa1\x02\n" +
 	" com.einride.decap.cms.example.v1B\x10KitchenSinkProtoP\x01ZVgo.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/example/v1;examplev1\xa2\x02\x04EDCE\xaa\x02\x1cEinride.Decap.Cms.Example.V1\xca\x02\x1cEinride\\Decap\\Cms\\Example\\V1\xe2\x02(Einride\\Decap\\Cms\\Example\\V1\\GPBMetadata\xea\x02 Einride::Decap::Cms::Example::V1b\x06proto3"
 
 var (
@@ -327,23 +814,55 @@ func file_einride_decap_cms_example_v1_kitchen_sink_proto_rawDescGZIP() []byte {
 }
 
 var file_einride_decap_cms_example_v1_kitchen_sink_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_einride_decap_cms_example_v1_kitchen_sink_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_einride_decap_cms_example_v1_kitchen_sink_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_einride_decap_cms_example_v1_kitchen_sink_proto_goTypes = []any{
-	(KitchenSink_ExampleEnum)(0),  // 0: einride.decap.cms.example.v1.KitchenSink.ExampleEnum
-	(*KitchenSink)(nil),           // 1: einride.decap.cms.example.v1.KitchenSink
-	(*KitchenSink_SomeSpec)(nil),  // 2: einride.decap.cms.example.v1.KitchenSink.SomeSpec
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(KitchenSink_ExampleEnum)(0),        // 0: einride.decap.cms.example.v1.KitchenSink.ExampleEnum
+	(*KitchenSink)(nil),                 // 1: einride.decap.cms.example.v1.KitchenSink
+	nil,                                 // 2: einride.decap.cms.example.v1.KitchenSink.LabelsEntry
+	nil,                                 // 3: einride.decap.cms.example.v1.KitchenSink.SpecMapEntry
+	(*KitchenSink_SomeSpec)(nil),        // 4: einride.decap.cms.example.v1.KitchenSink.SomeSpec
+	(*timestamppb.Timestamp)(nil),       // 5: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),         // 6: google.protobuf.Duration
+	(*structpb.Struct)(nil),             // 7: google.protobuf.Struct
+	(*wrapperspb.Int64Value)(nil),       // 8: google.protobuf.Int64Value
+	(*wrapperspb.StringValue)(nil),      // 9: google.protobuf.StringValue
+	(*fieldmaskpb.FieldMask)(nil),       // 10: google.protobuf.FieldMask
+	(*date.Date)(nil),                   // 11: google.type.Date
+	(*timeofday.TimeOfDay)(nil),         // 12: google.type.TimeOfDay
+	(*latlng.LatLng)(nil),               // 13: google.type.LatLng
+	(*money.Money)(nil),                 // 14: google.type.Money
+	(*color.Color)(nil),                 // 15: google.type.Color
+	(*postaladdress.PostalAddress)(nil), // 16: google.type.PostalAddress
+	(*emptypb.Empty)(nil),               // 17: google.protobuf.Empty
 }
 var file_einride_decap_cms_example_v1_kitchen_sink_proto_depIdxs = []int32{
-	3, // 0: einride.decap.cms.example.v1.KitchenSink.create_time:type_name -> google.protobuf.Timestamp
-	3, // 1: einride.decap.cms.example.v1.KitchenSink.revision_create_time:type_name -> google.protobuf.Timestamp
-	0, // 2: einride.decap.cms.example.v1.KitchenSink.example_enum:type_name -> einride.decap.cms.example.v1.KitchenSink.ExampleEnum
-	2, // 3: einride.decap.cms.example.v1.KitchenSink.specs:type_name -> einride.decap.cms.example.v1.KitchenSink.SomeSpec
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5,  // 0: einride.decap.cms.example.v1.KitchenSink.create_time:type_name -> google.protobuf.Timestamp
+	5,  // 1: einride.decap.cms.example.v1.KitchenSink.revision_create_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: einride.decap.cms.example.v1.KitchenSink.example_enum:type_name -> einride.decap.cms.example.v1.KitchenSink.ExampleEnum
+	4,  // 3: einride.decap.cms.example.v1.KitchenSink.specs:type_name -> einride.decap.cms.example.v1.KitchenSink.SomeSpec
+	0,  // 4: einride.decap.cms.example.v1.KitchenSink.example_enums:type_name -> einride.decap.cms.example.v1.KitchenSink.ExampleEnum
+	2,  // 5: einride.decap.cms.example.v1.KitchenSink.labels:type_name -> einride.decap.cms.example.v1.KitchenSink.LabelsEntry
+	3,  // 6: einride.decap.cms.example.v1.KitchenSink.spec_map:type_name -> einride.decap.cms.example.v1.KitchenSink.SpecMapEntry
+	6,  // 7: einride.decap.cms.example.v1.KitchenSink.duration:type_name -> google.protobuf.Duration
+	6,  // 8: einride.decap.cms.example.v1.KitchenSink.durations:type_name -> google.protobuf.Duration
+	7,  // 9: einride.decap.cms.example.v1.KitchenSink.metadata:type_name -> google.protobuf.Struct
+	8,  // 10: einride.decap.cms.example.v1.KitchenSink.nullable_int64_value:type_name -> google.protobuf.Int64Value
+	9,  // 11: einride.decap.cms.example.v1.KitchenSink.nullable_string_value:type_name -> google.protobuf.StringValue
+	10, // 12: einride.decap.cms.example.v1.KitchenSink.field_mask:type_name -> google.protobuf.FieldMask
+	11, // 13: einride.decap.cms.example.v1.KitchenSink.date:type_name -> google.type.Date
+	12, // 14: einride.decap.cms.example.v1.KitchenSink.time_of_day:type_name -> google.type.TimeOfDay
+	13, // 15: einride.decap.cms.example.v1.KitchenSink.location:type_name -> google.type.LatLng
+	14, // 16: einride.decap.cms.example.v1.KitchenSink.price:type_name -> google.type.Money
+	15, // 17: einride.decap.cms.example.v1.KitchenSink.color:type_name -> google.type.Color
+	16, // 18: einride.decap.cms.example.v1.KitchenSink.address:type_name -> google.type.PostalAddress
+	4,  // 19: einride.decap.cms.example.v1.KitchenSink.oneof_spec:type_name -> einride.decap.cms.example.v1.KitchenSink.SomeSpec
+	17, // 20: einride.decap.cms.example.v1.KitchenSink.oneof_empty:type_name -> google.protobuf.Empty
+	4,  // 21: einride.decap.cms.example.v1.KitchenSink.SpecMapEntry.value:type_name -> einride.decap.cms.example.v1.KitchenSink.SomeSpec
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_einride_decap_cms_example_v1_kitchen_sink_proto_init() }
@@ -351,13 +870,18 @@ func file_einride_decap_cms_example_v1_kitchen_sink_proto_init() {
 	if File_einride_decap_cms_example_v1_kitchen_sink_proto != nil {
 		return
 	}
+	file_einride_decap_cms_example_v1_kitchen_sink_proto_msgTypes[0].OneofWrappers = []any{
+		(*KitchenSink_OneofString)(nil),
+		(*KitchenSink_OneofSpec)(nil),
+		(*KitchenSink_OneofEmpty)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_einride_decap_cms_example_v1_kitchen_sink_proto_rawDesc), len(file_einride_decap_cms_example_v1_kitchen_sink_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,149 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: einride/decap/cms/example/v1/settings.proto
+
+package examplev1
+
+import (
+	_ "go.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Settings of the example library.
+type Settings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the settings.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the library.
+	LibraryName string `protobuf:"bytes,2,opt,name=library_name,json=libraryName,proto3" json:"library_name,omitempty"`
+	// Value indicating whether new books can be added.
+	AllowNewBooks bool `protobuf:"varint,3,opt,name=allow_new_books,json=allowNewBooks,proto3" json:"allow_new_books,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Settings) Reset() {
+	*x = Settings{}
+	mi := &file_einride_decap_cms_example_v1_settings_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settings) ProtoMessage() {}
+
+func (x *Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_decap_cms_example_v1_settings_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settings.ProtoReflect.Descriptor instead.
+func (*Settings) Descriptor() ([]byte, []int) {
+	return file_einride_decap_cms_example_v1_settings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Settings) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Settings) GetLibraryName() string {
+	if x != nil {
+		return x.LibraryName
+	}
+	return ""
+}
+
+func (x *Settings) GetAllowNewBooks() bool {
+	if x != nil {
+		return x.AllowNewBooks
+	}
+	return false
+}
+
+var File_einride_decap_cms_example_v1_settings_proto protoreflect.FileDescriptor
+
+const file_einride_decap_cms_example_v1_settings_proto_rawDesc = "" +
+	"\n" +
+	"+einride/decap/cms/example/v1/settings.proto\x12\x1ceinride.decap.cms.example.v1\x1a&einride/decap/cms/v1/annotations.proto\x1a\x19google/api/resource.proto\"\xdc\x01\n" +
+	"\bSettings\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\flibrary_name\x18\x02 \x01(\tR\vlibraryName\x12&\n" +
+	"\x0fallow_new_books\x18\x03 \x01(\bR\rallowNewBooks:q\xeaA=\n" +
+	"'decap-cms-example.einride.tech/Settings\x12\bsettings2\bsettings\xda\xf6\xf1\x97\x02+\n" +
+	"\bsettings\x1a\bSettings2\x10example/settingsR\x00\xf0\x01\x03B\x9e\x02\n" +
+	" com.einride.decap.cms.example.v1B\rSettingsProtoP\x01ZVgo.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/example/v1;examplev1\xa2\x02\x04EDCE\xaa\x02\x1cEinride.Decap.Cms.Example.V1\xca\x02\x1cEinride\\Decap\\Cms\\Example\\V1\xe2\x02(Einride\\Decap\\Cms\\Example\\V1\\GPBMetadata\xea\x02 Einride::Decap::Cms::Example::V1b\x06proto3"
+
+var (
+	file_einride_decap_cms_example_v1_settings_proto_rawDescOnce sync.Once
+	file_einride_decap_cms_example_v1_settings_proto_rawDescData []byte
+)
+
+func file_einride_decap_cms_example_v1_settings_proto_rawDescGZIP() []byte {
+	file_einride_decap_cms_example_v1_settings_proto_rawDescOnce.Do(func() {
+		file_einride_decap_cms_example_v1_settings_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_einride_decap_cms_example_v1_settings_proto_rawDesc), len(file_einride_decap_cms_example_v1_settings_proto_rawDesc)))
+	})
+	return file_einride_decap_cms_example_v1_settings_proto_rawDescData
+}
+
+var file_einride_decap_cms_example_v1_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_einride_decap_cms_example_v1_settings_proto_goTypes = []any{
+	(*Settings)(nil), // 0: einride.decap.cms.example.v1.Settings
+}
+var file_einride_decap_cms_example_v1_settings_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_einride_decap_cms_example_v1_settings_proto_init() }
+func file_einride_decap_cms_example_v1_settings_proto_init() {
+	if File_einride_decap_cms_example_v1_settings_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_einride_decap_cms_example_v1_settings_proto_rawDesc), len(file_einride_decap_cms_example_v1_settings_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_einride_decap_cms_example_v1_settings_proto_goTypes,
+		DependencyIndexes: file_einride_decap_cms_example_v1_settings_proto_depIdxs,
+		MessageInfos:      file_einride_decap_cms_example_v1_settings_proto_msgTypes,
+	}.Build()
+	File_einride_decap_cms_example_v1_settings_proto = out.File
+	file_einride_decap_cms_example_v1_settings_proto_goTypes = nil
+	file_einride_decap_cms_example_v1_settings_proto_depIdxs = nil
+}
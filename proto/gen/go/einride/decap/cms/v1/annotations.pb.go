@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -127,6 +128,80 @@ func (Config_Slug_Encoding) EnumDescriptor() ([]byte, []int) {
 	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{0, 2, 0}
 }
 
+// Format of entry files.
+type Collection_Format int32
+
+const (
+	// Default value. This value is unused.
+	Collection_FORMAT_UNSPECIFIED Collection_Format = 0
+	// YAML, stored with the yml extension.
+	Collection_YAML Collection_Format = 1
+	// TOML.
+	Collection_TOML Collection_Format = 2
+	// JSON.
+	Collection_JSON Collection_Format = 3
+	// Markdown with YAML, TOML or JSON frontmatter, detected from the frontmatter delimiter.
+	// New entries are stored with YAML frontmatter.
+	Collection_FRONTMATTER Collection_Format = 4
+	// Markdown with YAML frontmatter.
+	Collection_YAML_FRONTMATTER Collection_Format = 5
+	// Markdown with TOML frontmatter.
+	Collection_TOML_FRONTMATTER Collection_Format = 6
+	// Markdown with JSON frontmatter.
+	Collection_JSON_FRONTMATTER Collection_Format = 7
+)
+
+// Enum value maps for Collection_Format.
+var (
+	Collection_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "YAML",
+		2: "TOML",
+		3: "JSON",
+		4: "FRONTMATTER",
+		5: "YAML_FRONTMATTER",
+		6: "TOML_FRONTMATTER",
+		7: "JSON_FRONTMATTER",
+	}
+	Collection_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"YAML":               1,
+		"TOML":               2,
+		"JSON":               3,
+		"FRONTMATTER":        4,
+		"YAML_FRONTMATTER":   5,
+		"TOML_FRONTMATTER":   6,
+		"JSON_FRONTMATTER":   7,
+	}
+)
+
+func (x Collection_Format) Enum() *Collection_Format {
+	p := new(Collection_Format)
+	*p = x
+	return p
+}
+
+func (x Collection_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Collection_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_einride_decap_cms_v1_annotations_proto_enumTypes[2].Descriptor()
+}
+
+func (Collection_Format) Type() protoreflect.EnumType {
+	return &file_einride_decap_cms_v1_annotations_proto_enumTypes[2]
+}
+
+func (x Collection_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Collection_Format.Descriptor instead.
+func (Collection_Format) EnumDescriptor() ([]byte, []int) {
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{1, 0}
+}
+
 // GeoJSON type.
 type MapWidget_Type int32
 
@@ -168,11 +243,11 @@ func (x MapWidget_Type) String() string {
 }
 
 func (MapWidget_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_einride_decap_cms_v1_annotations_proto_enumTypes[2].Descriptor()
+	return file_einride_decap_cms_v1_annotations_proto_enumTypes[3].Descriptor()
 }
 
 func (MapWidget_Type) Type() protoreflect.EnumType {
-	return &file_einride_decap_cms_v1_annotations_proto_enumTypes[2]
+	return &file_einride_decap_cms_v1_annotations_proto_enumTypes[3]
 }
 
 func (x MapWidget_Type) Number() protoreflect.EnumNumber {
@@ -181,7 +256,7 @@ func (x MapWidget_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MapWidget_Type.Descriptor instead.
 func (MapWidget_Type) EnumDescriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{14, 0}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{16, 0}
 }
 
 // Value type of the number widget.
@@ -225,11 +300,11 @@ func (x NumberWidget_ValueType) String() string {
 }
 
 func (NumberWidget_ValueType) Descriptor() protoreflect.EnumDescriptor {
-	return file_einride_decap_cms_v1_annotations_proto_enumTypes[3].Descriptor()
+	return file_einride_decap_cms_v1_annotations_proto_enumTypes[4].Descriptor()
 }
 
 func (NumberWidget_ValueType) Type() protoreflect.EnumType {
-	return &file_einride_decap_cms_v1_annotations_proto_enumTypes[3]
+	return &file_einride_decap_cms_v1_annotations_proto_enumTypes[4]
 }
 
 func (x NumberWidget_ValueType) Number() protoreflect.EnumNumber {
@@ -238,7 +313,7 @@ func (x NumberWidget_ValueType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NumberWidget_ValueType.Descriptor instead.
 func (NumberWidget_ValueType) EnumDescriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{16, 0}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{18, 0}
 }
 
 // Decap CMS config.
@@ -365,7 +440,10 @@ type Collection struct {
 	Folder string `protobuf:"bytes,6,opt,name=folder,proto3" json:"folder,omitempty"`
 	// True allows users to create new items in the collection; defaults to false.
 	Create bool `protobuf:"varint,7,opt,name=create,proto3" json:"create,omitempty"`
-	// TODO.
+	// Format of the entry files, as a Decap CMS format name.
+	// Deprecated: Use file_format.
+	//
+	// Deprecated: Marked as deprecated in einride/decap/cms/v1/annotations.proto.
 	Format string `protobuf:"bytes,8,opt,name=format,proto3" json:"format,omitempty"`
 	// TODO.
 	Summary string `protobuf:"bytes,9,opt,name=summary,proto3" json:"summary,omitempty"`
@@ -374,7 +452,62 @@ type Collection struct {
 	// Maps editor UI widgets to field-value pairs in the saved file.
 	Fields []*Field `protobuf:"bytes,11,rep,name=fields,proto3" json:"fields,omitempty"`
 	// Owner of the collection.
-	Owner         *Owner `protobuf:"bytes,12,opt,name=owner,proto3" json:"owner,omitempty"`
+	Owner *Owner `protobuf:"bytes,12,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Nested collection config, for collections of hierarchical resources.
+	// Inferred from the resource name pattern of the collection's message, unless disabled.
+	// Singletons of a parent, such as "users/{user}/config", are inferred as a nested collection of
+	// index files named after the singleton, such as "<folder>/<user>/config.json".
+	Nested *Collection_Nested `protobuf:"bytes,13,opt,name=nested,proto3" json:"nested,omitempty"`
+	// Files of a file collection, instead of a folder of entries.
+	// Inferred for singleton resources, with a resource name pattern without variables.
+	Files []*Collection_File `protobuf:"bytes,14,rep,name=files,proto3" json:"files,omitempty"`
+	// Fields that entries can be sorted by in the collection view.
+	// Inferred from the fields of the summary template and timestamp fields.
+	SortableFields []string `protobuf:"bytes,15,rep,name=sortable_fields,json=sortableFields,proto3" json:"sortable_fields,omitempty"`
+	// Filters of entries in the collection view.
+	// Inferred with one filter per enum value and one per boolean field.
+	ViewFilters []*Collection_ViewFilter `protobuf:"bytes,16,rep,name=view_filters,json=viewFilters,proto3" json:"view_filters,omitempty"`
+	// Groupings of entries in the collection view.
+	// Inferred for enum fields with few values.
+	ViewGroups []*Collection_ViewGroup `protobuf:"bytes,17,rep,name=view_groups,json=viewGroups,proto3" json:"view_groups,omitempty"`
+	// Template for the file names of new entries in a folder collection, such as "{{year}}-{{slug}}".
+	// Inferred for top-level resources with a single pattern, such as "books/{book}", as the final ID of
+	// the resource name. Decap CMS templates have no filter to take the final segment of a resource
+	// name, so the name field of these entries holds the final ID, such as "alice-in-wonderland", which
+	// is expanded to the resource name when reading entries with package decapjson.
+	Slug string `protobuf:"bytes,18,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Template for the paths of new entries relative to the collection folder, such as
+	// "{{year}}/{{slug}}", for storing entries in subfolders. Without an extension.
+	Path string `protobuf:"bytes,19,opt,name=path,proto3" json:"path,omitempty"`
+	// Folder where uploaded media of the collection's entries are stored, relative to the entry,
+	// or to the repository root when starting with "/". Defaults to the global media folder.
+	MediaFolder string `protobuf:"bytes,20,opt,name=media_folder,json=mediaFolder,proto3" json:"media_folder,omitempty"`
+	// Path to the collection's media in the published site; defaults to the media folder.
+	PublicFolder string `protobuf:"bytes,21,opt,name=public_folder,json=publicFolder,proto3" json:"public_folder,omitempty"`
+	// Template for the URL of an entry in the published site, linked from the editor.
+	PreviewPath string `protobuf:"bytes,22,opt,name=preview_path,json=previewPath,proto3" json:"preview_path,omitempty"`
+	// Name of the date field used by the date placeholders of the preview path template.
+	PreviewPathDateField string `protobuf:"bytes,23,opt,name=preview_path_date_field,json=previewPathDateField,proto3" json:"preview_path_date_field,omitempty"`
+	// File extension of entries, for formats that are stored with several extensions.
+	// Defaults to the extension of the format.
+	Extension string `protobuf:"bytes,24,opt,name=extension,proto3" json:"extension,omitempty"`
+	// Delimiters of the frontmatter of entries, for frontmatter formats. A single delimiter is used
+	// both before and after the frontmatter, while two delimiters are used before and after it.
+	// Generation fails for delimiters of collections with a non-frontmatter format.
+	FrontmatterDelimiter []string `protobuf:"bytes,25,rep,name=frontmatter_delimiter,json=frontmatterDelimiter,proto3" json:"frontmatter_delimiter,omitempty"`
+	// Set to false to prevent deleting entries of the collection; defaults to true.
+	Delete *bool `protobuf:"varint,26,opt,name=delete,proto3,oneof" json:"delete,omitempty"`
+	// Set to false to hide the publish controls of the editorial workflow; defaults to true.
+	Publish *bool `protobuf:"varint,27,opt,name=publish,proto3,oneof" json:"publish,omitempty"`
+	// Set to true to hide the collection in the editor UI, such as for collections only referenced
+	// by relation widgets.
+	Hide bool `protobuf:"varint,28,opt,name=hide,proto3" json:"hide,omitempty"`
+	// Filter of the entries in the collection folder, for storing entries of several messages in one
+	// folder. The filter field of the message is edited with a hidden widget, with the filter value as
+	// its default, so that new entries match the filter of their collection.
+	Filter *Collection_Filter `protobuf:"bytes,29,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Format of the entry files; defaults to the format inferred by Decap CMS from the file extension.
+	FileFormat    Collection_Format `protobuf:"varint,30,opt,name=file_format,json=fileFormat,proto3,enum=einride.decap.cms.v1.Collection_Format" json:"file_format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -458,6 +591,7 @@ func (x *Collection) GetCreate() bool {
 	return false
 }
 
+// Deprecated: Marked as deprecated in einride/decap/cms/v1/annotations.proto.
 func (x *Collection) GetFormat() string {
 	if x != nil {
 		return x.Format
@@ -493,6 +627,132 @@ func (x *Collection) GetOwner() *Owner {
 	return nil
 }
 
+func (x *Collection) GetNested() *Collection_Nested {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+func (x *Collection) GetFiles() []*Collection_File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *Collection) GetSortableFields() []string {
+	if x != nil {
+		return x.SortableFields
+	}
+	return nil
+}
+
+func (x *Collection) GetViewFilters() []*Collection_ViewFilter {
+	if x != nil {
+		return x.ViewFilters
+	}
+	return nil
+}
+
+func (x *Collection) GetViewGroups() []*Collection_ViewGroup {
+	if x != nil {
+		return x.ViewGroups
+	}
+	return nil
+}
+
+func (x *Collection) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Collection) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Collection) GetMediaFolder() string {
+	if x != nil {
+		return x.MediaFolder
+	}
+	return ""
+}
+
+func (x *Collection) GetPublicFolder() string {
+	if x != nil {
+		return x.PublicFolder
+	}
+	return ""
+}
+
+func (x *Collection) GetPreviewPath() string {
+	if x != nil {
+		return x.PreviewPath
+	}
+	return ""
+}
+
+func (x *Collection) GetPreviewPathDateField() string {
+	if x != nil {
+		return x.PreviewPathDateField
+	}
+	return ""
+}
+
+func (x *Collection) GetExtension() string {
+	if x != nil {
+		return x.Extension
+	}
+	return ""
+}
+
+func (x *Collection) GetFrontmatterDelimiter() []string {
+	if x != nil {
+		return x.FrontmatterDelimiter
+	}
+	return nil
+}
+
+func (x *Collection) GetDelete() bool {
+	if x != nil && x.Delete != nil {
+		return *x.Delete
+	}
+	return false
+}
+
+func (x *Collection) GetPublish() bool {
+	if x != nil && x.Publish != nil {
+		return *x.Publish
+	}
+	return false
+}
+
+func (x *Collection) GetHide() bool {
+	if x != nil {
+		return x.Hide
+	}
+	return false
+}
+
+func (x *Collection) GetFilter() *Collection_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *Collection) GetFileFormat() Collection_Format {
+	if x != nil {
+		return x.FileFormat
+	}
+	return Collection_FORMAT_UNSPECIFIED
+}
+
 // An owner.
 type Owner struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -556,14 +816,20 @@ type Field struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// Label for the field in the editor UI; defaults to the value of name.
 	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	// Optional comment to add before the field (only supported for yaml).
+	// Optional comment to add before the field.
+	// Only emitted for formats that support comments: YAML and Markdown with YAML frontmatter.
 	Comment string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
 	// The field widget.
 	Widget *Widget `protobuf:"bytes,4,opt,name=widget,proto3" json:"widget,omitempty"`
 	// Flag indicating that this field should be ignored by Decap CMS.
 	Ignore bool `protobuf:"varint,5,opt,name=ignore,proto3" json:"ignore,omitempty"`
 	// Owner of the field (automatically appended to the label).
-	Owner         *Owner `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	Owner *Owner `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Set to true to store the field as the Markdown body of entries of frontmatter formats, after the
+	// frontmatter. The field must be a string field, and is edited with the markdown widget by default.
+	// At most one field of a collection can be the body.
+	// Use decapjson.UnmarshalFrontmatter to read entries with a body field.
+	Body          bool `protobuf:"varint,7,opt,name=body,proto3" json:"body,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -640,6 +906,59 @@ func (x *Field) GetOwner() *Owner {
 	return nil
 }
 
+func (x *Field) GetBody() bool {
+	if x != nil {
+		return x.Body
+	}
+	return false
+}
+
+// Decap CMS enum value config.
+type EnumValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Label for the value in select widgets; defaults to the first sentence of the value comment.
+	Label         string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnumValue) Reset() {
+	*x = EnumValue{}
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnumValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumValue) ProtoMessage() {}
+
+func (x *EnumValue) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumValue.ProtoReflect.Descriptor instead.
+func (*EnumValue) Descriptor() ([]byte, []int) {
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EnumValue) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
 // Widgets define the data type and interface for entry fields.
 type Widget struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -677,7 +996,7 @@ type Widget struct {
 
 func (x *Widget) Reset() {
 	*x = Widget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[4]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -689,7 +1008,7 @@ func (x *Widget) String() string {
 func (*Widget) ProtoMessage() {}
 
 func (x *Widget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[4]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +1021,7 @@ func (x *Widget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Widget.ProtoReflect.Descriptor instead.
 func (*Widget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{4}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Widget) GetRequiredValue() bool {
@@ -1015,14 +1334,19 @@ type CustomWidget struct {
 	// Type of the custom widget.
 	Widget string `protobuf:"bytes,1,opt,name=widget,proto3" json:"widget,omitempty"`
 	// Widget options, as raw YAML strings appended to the custom widget.
-	Options       []string `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Prefer structured_options, which are always serialized to valid YAML.
+	// Options can't repeat each other or the keys of the field, such as name, label, required or widget.
+	Options []string `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	// Widget options, serialized to YAML and appended to the custom widget after the raw options.
+	// Options can't repeat the raw options or the keys of the field.
+	StructuredOptions *structpb.Struct `protobuf:"bytes,3,opt,name=structured_options,json=structuredOptions,proto3" json:"structured_options,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CustomWidget) Reset() {
 	*x = CustomWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[5]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1034,7 +1358,7 @@ func (x *CustomWidget) String() string {
 func (*CustomWidget) ProtoMessage() {}
 
 func (x *CustomWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[5]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,7 +1371,7 @@ func (x *CustomWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomWidget.ProtoReflect.Descriptor instead.
 func (*CustomWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{5}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CustomWidget) GetWidget() string {
@@ -1064,18 +1388,25 @@ func (x *CustomWidget) GetOptions() []string {
 	return nil
 }
 
+func (x *CustomWidget) GetStructuredOptions() *structpb.Struct {
+	if x != nil {
+		return x.StructuredOptions
+	}
+	return nil
+}
+
 // The boolean widget translates a toggle switch input to a true/false value.
 type BooleanWidget struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Default value.
-	DefaultValue  bool `protobuf:"varint,1,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// Default value; if unset, the field is empty until edited, such as a null wrapper value.
+	DefaultValue  *bool `protobuf:"varint,1,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BooleanWidget) Reset() {
 	*x = BooleanWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[6]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1087,7 +1418,7 @@ func (x *BooleanWidget) String() string {
 func (*BooleanWidget) ProtoMessage() {}
 
 func (x *BooleanWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[6]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1100,12 +1431,12 @@ func (x *BooleanWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BooleanWidget.ProtoReflect.Descriptor instead.
 func (*BooleanWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{6}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BooleanWidget) GetDefaultValue() bool {
-	if x != nil {
-		return x.DefaultValue
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
 	}
 	return false
 }
@@ -1118,14 +1449,16 @@ type CodeWidget struct {
 	// Allows syntax to be changed.
 	AllowLanguageSelection bool `protobuf:"varint,2,opt,name=allow_language_selection,json=allowLanguageSelection,proto3" json:"allow_language_selection,omitempty"`
 	// Sets key names if outputting to an object.
-	Keys          *CodeWidget_Keys `protobuf:"bytes,3,opt,name=keys,proto3" json:"keys,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Keys *CodeWidget_Keys `protobuf:"bytes,3,opt,name=keys,proto3" json:"keys,omitempty"`
+	// Set to true to store only the code as a string, instead of an object with code and language.
+	OutputCodeOnly bool `protobuf:"varint,4,opt,name=output_code_only,json=outputCodeOnly,proto3" json:"output_code_only,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CodeWidget) Reset() {
 	*x = CodeWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[7]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1137,7 +1470,7 @@ func (x *CodeWidget) String() string {
 func (*CodeWidget) ProtoMessage() {}
 
 func (x *CodeWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[7]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,7 +1483,7 @@ func (x *CodeWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CodeWidget.ProtoReflect.Descriptor instead.
 func (*CodeWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{7}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CodeWidget) GetDefaultLanguage() string {
@@ -1174,6 +1507,13 @@ func (x *CodeWidget) GetKeys() *CodeWidget_Keys {
 	return nil
 }
 
+func (x *CodeWidget) GetOutputCodeOnly() bool {
+	if x != nil {
+		return x.OutputCodeOnly
+	}
+	return false
+}
+
 // The color widget translates a color picker to a color string.
 type ColorWidget struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1189,7 +1529,7 @@ type ColorWidget struct {
 
 func (x *ColorWidget) Reset() {
 	*x = ColorWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[8]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1201,7 +1541,7 @@ func (x *ColorWidget) String() string {
 func (*ColorWidget) ProtoMessage() {}
 
 func (x *ColorWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[8]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,7 +1554,7 @@ func (x *ColorWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ColorWidget.ProtoReflect.Descriptor instead.
 func (*ColorWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{8}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ColorWidget) GetDefaultValue() string {
@@ -1243,7 +1583,7 @@ type DateTimeWidget struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Accepts a datetime string, or an empty string to accept blank input;
 	// otherwise defaults to current datetime.
-	DefaultValue string `protobuf:"bytes,1,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	DefaultValue *string `protobuf:"bytes,1,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
 	// Sets storage format; accepts Day.js formats; defaults to ISO8601.
 	// If set, date_format and time_format are not used.
 	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
@@ -1257,14 +1597,18 @@ type DateTimeWidget struct {
 	// the datetime picker will display times in the user's local timezone.
 	// When using date-only formats, it can be helpful to set this to true so
 	// users in all time zones will see the same date in the datetime picker.
-	PickerUtc     bool `protobuf:"varint,5,opt,name=picker_utc,json=pickerUtc,proto3" json:"picker_utc,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	PickerUtc bool `protobuf:"varint,5,opt,name=picker_utc,json=pickerUtc,proto3" json:"picker_utc,omitempty"`
+	// Hides the date picker, for time-only values; date_format is not used.
+	DisableDateFormat bool `protobuf:"varint,6,opt,name=disable_date_format,json=disableDateFormat,proto3" json:"disable_date_format,omitempty"`
+	// Hides the time picker, for date-only values; time_format is not used.
+	DisableTimeFormat bool `protobuf:"varint,7,opt,name=disable_time_format,json=disableTimeFormat,proto3" json:"disable_time_format,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *DateTimeWidget) Reset() {
 	*x = DateTimeWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[9]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1276,7 +1620,7 @@ func (x *DateTimeWidget) String() string {
 func (*DateTimeWidget) ProtoMessage() {}
 
 func (x *DateTimeWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[9]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1289,12 +1633,12 @@ func (x *DateTimeWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DateTimeWidget.ProtoReflect.Descriptor instead.
 func (*DateTimeWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{9}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DateTimeWidget) GetDefaultValue() string {
-	if x != nil {
-		return x.DefaultValue
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
 	}
 	return ""
 }
@@ -1327,18 +1671,40 @@ func (x *DateTimeWidget) GetPickerUtc() bool {
 	return false
 }
 
+func (x *DateTimeWidget) GetDisableDateFormat() bool {
+	if x != nil {
+		return x.DisableDateFormat
+	}
+	return false
+}
+
+func (x *DateTimeWidget) GetDisableTimeFormat() bool {
+	if x != nil {
+		return x.DisableTimeFormat
+	}
+	return false
+}
+
 // The file widget allows editors to upload a file or select an existing one from the media library.
 type FileWidget struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Accepts a file path string.
-	DefaultValue  string `protobuf:"bytes,1,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"` // TODO: Add remaining fields.
+	DefaultValue string `protobuf:"bytes,1,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// Settings to apply when a media library is opened by the widget.
+	MediaLibrary *MediaLibrary `protobuf:"bytes,2,opt,name=media_library,json=mediaLibrary,proto3" json:"media_library,omitempty"`
+	// Folder path where uploaded files will be saved, relative to the entry.
+	// Overrides the collection and global media folder.
+	MediaFolder string `protobuf:"bytes,3,opt,name=media_folder,json=mediaFolder,proto3" json:"media_folder,omitempty"`
+	// Folder path used in the value of the field for uploaded files.
+	// Overrides the collection and global public folder.
+	PublicFolder  string `protobuf:"bytes,4,opt,name=public_folder,json=publicFolder,proto3" json:"public_folder,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FileWidget) Reset() {
 	*x = FileWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[10]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1350,7 +1716,7 @@ func (x *FileWidget) String() string {
 func (*FileWidget) ProtoMessage() {}
 
 func (x *FileWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[10]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1363,7 +1729,7 @@ func (x *FileWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileWidget.ProtoReflect.Descriptor instead.
 func (*FileWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{10}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *FileWidget) GetDefaultValue() string {
@@ -1373,25 +1739,46 @@ func (x *FileWidget) GetDefaultValue() string {
 	return ""
 }
 
-// Hidden widgets do not display in the UI.
-type HiddenWidget struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Accepts any valid data type.
-	//
-	// Types that are valid to be assigned to DefaultValue:
-	//
-	//	*HiddenWidget_DefaultBool
-	//	*HiddenWidget_DefaultString
-	//	*HiddenWidget_DefaultDouble
-	//	*HiddenWidget_DefaultInt64
-	DefaultValue  isHiddenWidget_DefaultValue `protobuf_oneof:"default_value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FileWidget) GetMediaLibrary() *MediaLibrary {
+	if x != nil {
+		return x.MediaLibrary
+	}
+	return nil
+}
+
+func (x *FileWidget) GetMediaFolder() string {
+	if x != nil {
+		return x.MediaFolder
+	}
+	return ""
+}
+
+func (x *FileWidget) GetPublicFolder() string {
+	if x != nil {
+		return x.PublicFolder
+	}
+	return ""
+}
+
+// Hidden widgets do not display in the UI.
+type HiddenWidget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Accepts any valid data type.
+	//
+	// Types that are valid to be assigned to DefaultValue:
+	//
+	//	*HiddenWidget_DefaultBool
+	//	*HiddenWidget_DefaultString
+	//	*HiddenWidget_DefaultDouble
+	//	*HiddenWidget_DefaultInt64
+	DefaultValue  isHiddenWidget_DefaultValue `protobuf_oneof:"default_value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HiddenWidget) Reset() {
 	*x = HiddenWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[11]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1403,7 +1790,7 @@ func (x *HiddenWidget) String() string {
 func (*HiddenWidget) ProtoMessage() {}
 
 func (x *HiddenWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[11]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,7 +1803,7 @@ func (x *HiddenWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HiddenWidget.ProtoReflect.Descriptor instead.
 func (*HiddenWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{11}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *HiddenWidget) GetDefaultValue() isHiddenWidget_DefaultValue {
@@ -1498,14 +1885,22 @@ func (*HiddenWidget_DefaultInt64) isHiddenWidget_DefaultValue() {}
 type ImageWidget struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Accepts a file path string.
-	DefaultValue  string `protobuf:"bytes,1,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"` // TODO: Add remaining fields.
+	DefaultValue string `protobuf:"bytes,1,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// Settings to apply when a media library is opened by the widget.
+	MediaLibrary *MediaLibrary `protobuf:"bytes,2,opt,name=media_library,json=mediaLibrary,proto3" json:"media_library,omitempty"`
+	// Folder path where uploaded images will be saved, relative to the entry.
+	// Overrides the collection and global media folder.
+	MediaFolder string `protobuf:"bytes,3,opt,name=media_folder,json=mediaFolder,proto3" json:"media_folder,omitempty"`
+	// Folder path used in the value of the field for uploaded images.
+	// Overrides the collection and global public folder.
+	PublicFolder  string `protobuf:"bytes,4,opt,name=public_folder,json=publicFolder,proto3" json:"public_folder,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ImageWidget) Reset() {
 	*x = ImageWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[12]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1517,7 +1912,7 @@ func (x *ImageWidget) String() string {
 func (*ImageWidget) ProtoMessage() {}
 
 func (x *ImageWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[12]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1530,7 +1925,7 @@ func (x *ImageWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageWidget.ProtoReflect.Descriptor instead.
 func (*ImageWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{12}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ImageWidget) GetDefaultValue() string {
@@ -1540,11 +1935,96 @@ func (x *ImageWidget) GetDefaultValue() string {
 	return ""
 }
 
+func (x *ImageWidget) GetMediaLibrary() *MediaLibrary {
+	if x != nil {
+		return x.MediaLibrary
+	}
+	return nil
+}
+
+func (x *ImageWidget) GetMediaFolder() string {
+	if x != nil {
+		return x.MediaFolder
+	}
+	return ""
+}
+
+func (x *ImageWidget) GetPublicFolder() string {
+	if x != nil {
+		return x.PublicFolder
+	}
+	return ""
+}
+
+// Media library settings of a file or image widget.
+type MediaLibrary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Allow multiple files to be selected, stored as a list of file paths; defaults to true.
+	AllowMultiple *bool `protobuf:"varint,1,opt,name=allow_multiple,json=allowMultiple,proto3,oneof" json:"allow_multiple,omitempty"`
+	// Show the button to insert a file from a URL; defaults to true.
+	ChooseUrl *bool `protobuf:"varint,2,opt,name=choose_url,json=chooseUrl,proto3,oneof" json:"choose_url,omitempty"`
+	// Configuration passed to the media library.
+	Config        *structpb.Struct `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaLibrary) Reset() {
+	*x = MediaLibrary{}
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaLibrary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaLibrary) ProtoMessage() {}
+
+func (x *MediaLibrary) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaLibrary.ProtoReflect.Descriptor instead.
+func (*MediaLibrary) Descriptor() ([]byte, []int) {
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MediaLibrary) GetAllowMultiple() bool {
+	if x != nil && x.AllowMultiple != nil {
+		return *x.AllowMultiple
+	}
+	return false
+}
+
+func (x *MediaLibrary) GetChooseUrl() bool {
+	if x != nil && x.ChooseUrl != nil {
+		return *x.ChooseUrl
+	}
+	return false
+}
+
+func (x *MediaLibrary) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
 // The list widget allows you to create a repeatable item in the UI which saves as a list of widget values.
 type ListWidget struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Shows the button to add additional items.
-	AllowAdd bool `protobuf:"varint,1,opt,name=allow_add,json=allowAdd,proto3" json:"allow_add,omitempty"`
+	// Shows the button to add additional items. Defaults to true.
+	AllowAdd *bool `protobuf:"varint,1,opt,name=allow_add,json=allowAdd,proto3,oneof" json:"allow_add,omitempty"`
 	// Collapse the entries.
 	Collapsed bool `protobuf:"varint,2,opt,name=collapsed,proto3" json:"collapsed,omitempty"`
 	// Specify the label displayed on collapsed entries.
@@ -1560,14 +2040,22 @@ type ListWidget struct {
 	// Minimum number of items in the list.
 	MinItems int64 `protobuf:"varint,8,opt,name=min_items,json=minItems,proto3" json:"min_items,omitempty"`
 	// Add new entries to the top of the list.
-	AddToTop      bool `protobuf:"varint,9,opt,name=add_to_top,json=addToTop,proto3" json:"add_to_top,omitempty"`
+	AddToTop bool `protobuf:"varint,9,opt,name=add_to_top,json=addToTop,proto3" json:"add_to_top,omitempty"`
+	// A single widget field to be repeated, for lists of single values.
+	// Only one of fields, field and types should be set.
+	Field *Field `protobuf:"bytes,10,opt,name=field,proto3" json:"field,omitempty"`
+	// Types of list items, for lists of items of different types.
+	// Each type is a field with an object widget, and the type of each item is stored in the type key.
+	Types []*Field `protobuf:"bytes,11,rep,name=types,proto3" json:"types,omitempty"`
+	// Name of the key that stores the type of each item, for lists with types. Defaults to "type".
+	TypeKey       string `protobuf:"bytes,12,opt,name=type_key,json=typeKey,proto3" json:"type_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListWidget) Reset() {
 	*x = ListWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[13]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1579,7 +2067,7 @@ func (x *ListWidget) String() string {
 func (*ListWidget) ProtoMessage() {}
 
 func (x *ListWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[13]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1592,12 +2080,12 @@ func (x *ListWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWidget.ProtoReflect.Descriptor instead.
 func (*ListWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{13}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListWidget) GetAllowAdd() bool {
-	if x != nil {
-		return x.AllowAdd
+	if x != nil && x.AllowAdd != nil {
+		return *x.AllowAdd
 	}
 	return false
 }
@@ -1658,6 +2146,27 @@ func (x *ListWidget) GetAddToTop() bool {
 	return false
 }
 
+func (x *ListWidget) GetField() *Field {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+func (x *ListWidget) GetTypes() []*Field {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ListWidget) GetTypeKey() string {
+	if x != nil {
+		return x.TypeKey
+	}
+	return ""
+}
+
 // The map widget allows you to edit spatial data using an interactive map.
 type MapWidget struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1674,7 +2183,7 @@ type MapWidget struct {
 
 func (x *MapWidget) Reset() {
 	*x = MapWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[14]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1686,7 +2195,7 @@ func (x *MapWidget) String() string {
 func (*MapWidget) ProtoMessage() {}
 
 func (x *MapWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[14]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,7 +2208,7 @@ func (x *MapWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MapWidget.ProtoReflect.Descriptor instead.
 func (*MapWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{14}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MapWidget) GetDecimals() int64 {
@@ -1737,7 +2246,7 @@ type MarkdownWidget struct {
 
 func (x *MarkdownWidget) Reset() {
 	*x = MarkdownWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[15]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1749,7 +2258,7 @@ func (x *MarkdownWidget) String() string {
 func (*MarkdownWidget) ProtoMessage() {}
 
 func (x *MarkdownWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[15]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1762,7 +2271,7 @@ func (x *MarkdownWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkdownWidget.ProtoReflect.Descriptor instead.
 func (*MarkdownWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{15}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *MarkdownWidget) GetDefaultValue() string {
@@ -1787,18 +2296,21 @@ type NumberWidget struct {
 	// The value type.
 	ValueType NumberWidget_ValueType `protobuf:"varint,2,opt,name=value_type,json=valueType,proto3,enum=einride.decap.cms.v1.NumberWidget_ValueType" json:"value_type,omitempty"`
 	// Minimum value accepted.
-	MinValue float64 `protobuf:"fixed64,3,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MinValue *float64 `protobuf:"fixed64,3,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
 	// Maximum value accepted.
-	MaxValue float64 `protobuf:"fixed64,4,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	MaxValue *float64 `protobuf:"fixed64,4,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
 	// Number for stepping up/down values. 1 by default.
-	Step          float64 `protobuf:"fixed64,5,opt,name=step,proto3" json:"step,omitempty"`
+	Step float64 `protobuf:"fixed64,5,opt,name=step,proto3" json:"step,omitempty"`
+	// Allow the number to be left empty, instead of defaulting to the default value.
+	// Decap CMS stores an empty number as an empty string.
+	AllowEmpty    bool `protobuf:"varint,6,opt,name=allow_empty,json=allowEmpty,proto3" json:"allow_empty,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NumberWidget) Reset() {
 	*x = NumberWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[16]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1810,7 +2322,7 @@ func (x *NumberWidget) String() string {
 func (*NumberWidget) ProtoMessage() {}
 
 func (x *NumberWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[16]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1823,7 +2335,7 @@ func (x *NumberWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NumberWidget.ProtoReflect.Descriptor instead.
 func (*NumberWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{16}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *NumberWidget) GetDefaultValue() float64 {
@@ -1841,15 +2353,15 @@ func (x *NumberWidget) GetValueType() NumberWidget_ValueType {
 }
 
 func (x *NumberWidget) GetMinValue() float64 {
-	if x != nil {
-		return x.MinValue
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
 	}
 	return 0
 }
 
 func (x *NumberWidget) GetMaxValue() float64 {
-	if x != nil {
-		return x.MaxValue
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
 	}
 	return 0
 }
@@ -1861,6 +2373,13 @@ func (x *NumberWidget) GetStep() float64 {
 	return 0
 }
 
+func (x *NumberWidget) GetAllowEmpty() bool {
+	if x != nil {
+		return x.AllowEmpty
+	}
+	return false
+}
+
 // The object widget allows you to group multiple widgets together, nested under a single field.
 type ObjectWidget struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1876,7 +2395,7 @@ type ObjectWidget struct {
 
 func (x *ObjectWidget) Reset() {
 	*x = ObjectWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[17]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1888,7 +2407,7 @@ func (x *ObjectWidget) String() string {
 func (*ObjectWidget) ProtoMessage() {}
 
 func (x *ObjectWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[17]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1901,7 +2420,7 @@ func (x *ObjectWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectWidget.ProtoReflect.Descriptor instead.
 func (*ObjectWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{17}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ObjectWidget) GetCollapsed() bool {
@@ -1946,7 +2465,7 @@ type RelationWidget struct {
 
 func (x *RelationWidget) Reset() {
 	*x = RelationWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[18]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1958,7 +2477,7 @@ func (x *RelationWidget) String() string {
 func (*RelationWidget) ProtoMessage() {}
 
 func (x *RelationWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[18]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1971,7 +2490,7 @@ func (x *RelationWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelationWidget.ProtoReflect.Descriptor instead.
 func (*RelationWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{18}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RelationWidget) GetCollection() string {
@@ -2035,7 +2554,7 @@ type SelectWidget struct {
 
 func (x *SelectWidget) Reset() {
 	*x = SelectWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[19]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2047,7 +2566,7 @@ func (x *SelectWidget) String() string {
 func (*SelectWidget) ProtoMessage() {}
 
 func (x *SelectWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[19]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2060,7 +2579,7 @@ func (x *SelectWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelectWidget.ProtoReflect.Descriptor instead.
 func (*SelectWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{19}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SelectWidget) GetDefaultValue() []string {
@@ -2109,7 +2628,7 @@ type StringWidget struct {
 
 func (x *StringWidget) Reset() {
 	*x = StringWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[20]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2121,7 +2640,7 @@ func (x *StringWidget) String() string {
 func (*StringWidget) ProtoMessage() {}
 
 func (x *StringWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[20]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2134,7 +2653,7 @@ func (x *StringWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringWidget.ProtoReflect.Descriptor instead.
 func (*StringWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{20}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *StringWidget) GetDefaultValue() string {
@@ -2155,7 +2674,7 @@ type TextWidget struct {
 
 func (x *TextWidget) Reset() {
 	*x = TextWidget{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[21]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2167,7 +2686,7 @@ func (x *TextWidget) String() string {
 func (*TextWidget) ProtoMessage() {}
 
 func (x *TextWidget) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[21]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2180,7 +2699,7 @@ func (x *TextWidget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TextWidget.ProtoReflect.Descriptor instead.
 func (*TextWidget) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{21}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *TextWidget) GetDefaultValue() string {
@@ -2218,7 +2737,7 @@ type Config_Backend struct {
 
 func (x *Config_Backend) Reset() {
 	*x = Config_Backend{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[22]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2230,7 +2749,7 @@ func (x *Config_Backend) String() string {
 func (*Config_Backend) ProtoMessage() {}
 
 func (x *Config_Backend) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[22]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2306,7 +2825,7 @@ type Config_LocalBackend struct {
 
 func (x *Config_LocalBackend) Reset() {
 	*x = Config_LocalBackend{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[23]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2318,7 +2837,7 @@ func (x *Config_LocalBackend) String() string {
 func (*Config_LocalBackend) ProtoMessage() {}
 
 func (x *Config_LocalBackend) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[23]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2361,7 +2880,7 @@ type Config_Slug struct {
 
 func (x *Config_Slug) Reset() {
 	*x = Config_Slug{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[24]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2373,7 +2892,7 @@ func (x *Config_Slug) String() string {
 func (*Config_Slug) ProtoMessage() {}
 
 func (x *Config_Slug) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[24]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2431,7 +2950,7 @@ type Config_Backend_CommitMessages struct {
 
 func (x *Config_Backend_CommitMessages) Reset() {
 	*x = Config_Backend_CommitMessages{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[25]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2443,7 +2962,7 @@ func (x *Config_Backend_CommitMessages) String() string {
 func (*Config_Backend_CommitMessages) ProtoMessage() {}
 
 func (x *Config_Backend_CommitMessages) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[25]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2512,7 +3031,7 @@ type Collection_Editor struct {
 
 func (x *Collection_Editor) Reset() {
 	*x = Collection_Editor{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[26]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2524,7 +3043,7 @@ func (x *Collection_Editor) String() string {
 func (*Collection_Editor) ProtoMessage() {}
 
 func (x *Collection_Editor) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[26]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2547,6 +3066,386 @@ func (x *Collection_Editor) GetPreview() bool {
 	return false
 }
 
+// Nested collection config.
+// Entries are stored in subfolders of the collection folder, given by the meta path of each entry.
+// The meta path is the path of the entry file relative to the collection folder, without extension.
+type Collection_Nested struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum depth of nesting.
+	Depth int64 `protobuf:"varint,1,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Summary template of entries in the collection tree.
+	Summary string `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Set to true to store each entry as an index file, in a subfolder named by its slug.
+	Subfolders bool `protobuf:"varint,3,opt,name=subfolders,proto3" json:"subfolders,omitempty"`
+	// Label of the meta path field.
+	PathLabel string `protobuf:"bytes,4,opt,name=path_label,json=pathLabel,proto3" json:"path_label,omitempty"`
+	// Validation pattern of the meta path field.
+	PathPattern *Widget_Pattern `protobuf:"bytes,5,opt,name=path_pattern,json=pathPattern,proto3" json:"path_pattern,omitempty"`
+	// Name of index files, for entries stored in subfolders.
+	IndexFile string `protobuf:"bytes,6,opt,name=index_file,json=indexFile,proto3" json:"index_file,omitempty"`
+	// Set to true to store entries of a hierarchical resource in a flat folder, instead of the
+	// nested collection config inferred from the resource name pattern.
+	Disabled      bool `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection_Nested) Reset() {
+	*x = Collection_Nested{}
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection_Nested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection_Nested) ProtoMessage() {}
+
+func (x *Collection_Nested) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection_Nested.ProtoReflect.Descriptor instead.
+func (*Collection_Nested) Descriptor() ([]byte, []int) {
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Collection_Nested) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Collection_Nested) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *Collection_Nested) GetSubfolders() bool {
+	if x != nil {
+		return x.Subfolders
+	}
+	return false
+}
+
+func (x *Collection_Nested) GetPathLabel() string {
+	if x != nil {
+		return x.PathLabel
+	}
+	return ""
+}
+
+func (x *Collection_Nested) GetPathPattern() *Widget_Pattern {
+	if x != nil {
+		return x.PathPattern
+	}
+	return nil
+}
+
+func (x *Collection_Nested) GetIndexFile() string {
+	if x != nil {
+		return x.IndexFile
+	}
+	return ""
+}
+
+func (x *Collection_Nested) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+// A filter of the entries in a folder, for collections of some of the entries in a folder.
+type Collection_Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Field to filter entries by.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Value that the field of entries in the collection must have.
+	// Only one of value and values should be set.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Values of which the field of entries in the collection must have one.
+	// New entries have the first value.
+	Values        []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection_Filter) Reset() {
+	*x = Collection_Filter{}
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection_Filter) ProtoMessage() {}
+
+func (x *Collection_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection_Filter.ProtoReflect.Descriptor instead.
+func (*Collection_Filter) Descriptor() ([]byte, []int) {
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *Collection_Filter) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Collection_Filter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Collection_Filter) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// A filter of entries in the collection view.
+type Collection_ViewFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Label of the filter in the editor UI.
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// Field to filter entries by.
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// Regular expression that the value of the field must match.
+	Pattern       string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection_ViewFilter) Reset() {
+	*x = Collection_ViewFilter{}
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection_ViewFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection_ViewFilter) ProtoMessage() {}
+
+func (x *Collection_ViewFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection_ViewFilter.ProtoReflect.Descriptor instead.
+func (*Collection_ViewFilter) Descriptor() ([]byte, []int) {
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *Collection_ViewFilter) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Collection_ViewFilter) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Collection_ViewFilter) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+// A grouping of entries in the collection view.
+type Collection_ViewGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Label of the group in the editor UI.
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// Field to group entries by.
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// Optional regular expression; entries are grouped by the part of the field value that matches.
+	Pattern       string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection_ViewGroup) Reset() {
+	*x = Collection_ViewGroup{}
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection_ViewGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection_ViewGroup) ProtoMessage() {}
+
+func (x *Collection_ViewGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection_ViewGroup.ProtoReflect.Descriptor instead.
+func (*Collection_ViewGroup) Descriptor() ([]byte, []int) {
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{1, 4}
+}
+
+func (x *Collection_ViewGroup) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Collection_ViewGroup) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Collection_ViewGroup) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+// A file of a file collection.
+type Collection_File struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the file.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Label for the file in the editor UI; defaults to the value of name.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Path of the file, relative to the base of the repo.
+	File string `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
+	// Optional text, displayed below the label when viewing the file.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Maps editor UI widgets to field-value pairs in the file.
+	// Defaults to the fields of the collection.
+	Fields        []*Field `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection_File) Reset() {
+	*x = Collection_File{}
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection_File) ProtoMessage() {}
+
+func (x *Collection_File) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection_File.ProtoReflect.Descriptor instead.
+func (*Collection_File) Descriptor() ([]byte, []int) {
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{1, 5}
+}
+
+func (x *Collection_File) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection_File) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Collection_File) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *Collection_File) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Collection_File) GetFields() []*Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 // Add field validation by specifying a list with a regex pattern and an error message.
 // More extensive validation can be achieved with custom widgets.
 type Widget_Pattern struct {
@@ -2561,7 +3460,7 @@ type Widget_Pattern struct {
 
 func (x *Widget_Pattern) Reset() {
 	*x = Widget_Pattern{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[27]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2573,7 +3472,7 @@ func (x *Widget_Pattern) String() string {
 func (*Widget_Pattern) ProtoMessage() {}
 
 func (x *Widget_Pattern) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[27]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2586,7 +3485,7 @@ func (x *Widget_Pattern) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Widget_Pattern.ProtoReflect.Descriptor instead.
 func (*Widget_Pattern) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{4, 0}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *Widget_Pattern) GetRegexp() string {
@@ -2616,7 +3515,7 @@ type CodeWidget_Keys struct {
 
 func (x *CodeWidget_Keys) Reset() {
 	*x = CodeWidget_Keys{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[28]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2628,7 +3527,7 @@ func (x *CodeWidget_Keys) String() string {
 func (*CodeWidget_Keys) ProtoMessage() {}
 
 func (x *CodeWidget_Keys) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[28]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2641,7 +3540,7 @@ func (x *CodeWidget_Keys) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CodeWidget_Keys.ProtoReflect.Descriptor instead.
 func (*CodeWidget_Keys) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{7, 0}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *CodeWidget_Keys) GetCode() string {
@@ -2671,7 +3570,7 @@ type RelationWidget_Filter struct {
 
 func (x *RelationWidget_Filter) Reset() {
 	*x = RelationWidget_Filter{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[29]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2683,7 +3582,7 @@ func (x *RelationWidget_Filter) String() string {
 func (*RelationWidget_Filter) ProtoMessage() {}
 
 func (x *RelationWidget_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[29]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2696,7 +3595,7 @@ func (x *RelationWidget_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelationWidget_Filter.ProtoReflect.Descriptor instead.
 func (*RelationWidget_Filter) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{18, 0}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{20, 0}
 }
 
 func (x *RelationWidget_Filter) GetField() string {
@@ -2726,7 +3625,7 @@ type SelectWidget_Option struct {
 
 func (x *SelectWidget_Option) Reset() {
 	*x = SelectWidget_Option{}
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[30]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2738,7 +3637,7 @@ func (x *SelectWidget_Option) String() string {
 func (*SelectWidget_Option) ProtoMessage() {}
 
 func (x *SelectWidget_Option) ProtoReflect() protoreflect.Message {
-	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[30]
+	mi := &file_einride_decap_cms_v1_annotations_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2751,7 +3650,7 @@ func (x *SelectWidget_Option) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelectWidget_Option.ProtoReflect.Descriptor instead.
 func (*SelectWidget_Option) Descriptor() ([]byte, []int) {
-	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{19, 0}
+	return file_einride_decap_cms_v1_annotations_proto_rawDescGZIP(), []int{21, 0}
 }
 
 func (x *SelectWidget_Option) GetLabel() string {
@@ -2793,6 +3692,14 @@ var file_einride_decap_cms_v1_annotations_proto_extTypes = []protoimpl.Extension
 		Tag:           "bytes,265097061,opt,name=field",
 		Filename:      "einride/decap/cms/v1/annotations.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*EnumValue)(nil),
+		Field:         98350796,
+		Name:          "einride.decap.cms.v1.enum_value",
+		Tag:           "bytes,98350796,opt,name=enum_value",
+		Filename:      "einride/decap/cms/v1/annotations.proto",
+	},
 }
 
 // Extension fields to descriptorpb.FileOptions.
@@ -2819,11 +3726,19 @@ var (
 	E_Field = &file_einride_decap_cms_v1_annotations_proto_extTypes[2]
 )
 
+// Extension fields to descriptorpb.EnumValueOptions.
+var (
+	// $((16#$(echo einride.decap.cms.v1.enum_value | sha256sum | cut -c 1-7)))
+	//
+	// optional einride.decap.cms.v1.EnumValue enum_value = 98350796;
+	E_EnumValue = &file_einride_decap_cms_v1_annotations_proto_extTypes[3]
+)
+
 var File_einride_decap_cms_v1_annotations_proto protoreflect.FileDescriptor
 
 const file_einride_decap_cms_v1_annotations_proto_rawDesc = "" +
 	"\n" +
-	"&einride/decap/cms/v1/annotations.proto\x12\x14einride.decap.cms.v1\x1a google/protobuf/descriptor.proto\"\xbf\t\n" +
+	"&einride/decap/cms/v1/annotations.proto\x12\x14einride.decap.cms.v1\x1a google/protobuf/descriptor.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xbf\t\n" +
 	"\x06Config\x12>\n" +
 	"\abackend\x18\x01 \x01(\v2$.einride.decap.cms.v1.Config.BackendR\abackend\x12N\n" +
 	"\rlocal_backend\x18\x02 \x01(\v2).einride.decap.cms.v1.Config.LocalBackendR\flocalBackend\x12K\n" +
@@ -2860,7 +3775,7 @@ const file_einride_decap_cms_v1_annotations_proto_rawDesc = "" +
 	"\x05ASCII\x10\x02\"C\n" +
 	"\vPublishMode\x12\x1c\n" +
 	"\x18PUBLISH_MODE_UNSPECIFIED\x10\x00\x12\x16\n" +
-	"\x12EDITORIAL_WORKFLOW\x10\x01\"\xd9\x03\n" +
+	"\x12EDITORIAL_WORKFLOW\x10\x01\"\xd5\x10\n" +
 	"\n" +
 	"Collection\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12)\n" +
@@ -2869,25 +3784,91 @@ const file_einride_decap_cms_v1_annotations_proto_rawDesc = "" +
 	"\x0elabel_singular\x18\x04 \x01(\tR\rlabelSingular\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x16\n" +
 	"\x06folder\x18\x06 \x01(\tR\x06folder\x12\x16\n" +
-	"\x06create\x18\a \x01(\bR\x06create\x12\x16\n" +
-	"\x06format\x18\b \x01(\tR\x06format\x12\x18\n" +
+	"\x06create\x18\a \x01(\bR\x06create\x12\x1a\n" +
+	"\x06format\x18\b \x01(\tB\x02\x18\x01R\x06format\x12\x18\n" +
 	"\asummary\x18\t \x01(\tR\asummary\x12?\n" +
 	"\x06editor\x18\n" +
 	" \x01(\v2'.einride.decap.cms.v1.Collection.EditorR\x06editor\x123\n" +
 	"\x06fields\x18\v \x03(\v2\x1b.einride.decap.cms.v1.FieldR\x06fields\x121\n" +
-	"\x05owner\x18\f \x01(\v2\x1b.einride.decap.cms.v1.OwnerR\x05owner\x1a\"\n" +
+	"\x05owner\x18\f \x01(\v2\x1b.einride.decap.cms.v1.OwnerR\x05owner\x12?\n" +
+	"\x06nested\x18\r \x01(\v2'.einride.decap.cms.v1.Collection.NestedR\x06nested\x12;\n" +
+	"\x05files\x18\x0e \x03(\v2%.einride.decap.cms.v1.Collection.FileR\x05files\x12'\n" +
+	"\x0fsortable_fields\x18\x0f \x03(\tR\x0esortableFields\x12N\n" +
+	"\fview_filters\x18\x10 \x03(\v2+.einride.decap.cms.v1.Collection.ViewFilterR\vviewFilters\x12K\n" +
+	"\vview_groups\x18\x11 \x03(\v2*.einride.decap.cms.v1.Collection.ViewGroupR\n" +
+	"viewGroups\x12\x12\n" +
+	"\x04slug\x18\x12 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04path\x18\x13 \x01(\tR\x04path\x12!\n" +
+	"\fmedia_folder\x18\x14 \x01(\tR\vmediaFolder\x12#\n" +
+	"\rpublic_folder\x18\x15 \x01(\tR\fpublicFolder\x12!\n" +
+	"\fpreview_path\x18\x16 \x01(\tR\vpreviewPath\x125\n" +
+	"\x17preview_path_date_field\x18\x17 \x01(\tR\x14previewPathDateField\x12\x1c\n" +
+	"\textension\x18\x18 \x01(\tR\textension\x123\n" +
+	"\x15frontmatter_delimiter\x18\x19 \x03(\tR\x14frontmatterDelimiter\x12\x1b\n" +
+	"\x06delete\x18\x1a \x01(\bH\x00R\x06delete\x88\x01\x01\x12\x1d\n" +
+	"\apublish\x18\x1b \x01(\bH\x01R\apublish\x88\x01\x01\x12\x12\n" +
+	"\x04hide\x18\x1c \x01(\bR\x04hide\x12?\n" +
+	"\x06filter\x18\x1d \x01(\v2'.einride.decap.cms.v1.Collection.FilterR\x06filter\x12H\n" +
+	"\vfile_format\x18\x1e \x01(\x0e2'.einride.decap.cms.v1.Collection.FormatR\n" +
+	"fileFormat\x1a\"\n" +
 	"\x06Editor\x12\x18\n" +
-	"\apreview\x18\x01 \x01(\bR\apreview\"<\n" +
+	"\apreview\x18\x01 \x01(\bR\apreview\x1a\xfb\x01\n" +
+	"\x06Nested\x12\x14\n" +
+	"\x05depth\x18\x01 \x01(\x03R\x05depth\x12\x18\n" +
+	"\asummary\x18\x02 \x01(\tR\asummary\x12\x1e\n" +
+	"\n" +
+	"subfolders\x18\x03 \x01(\bR\n" +
+	"subfolders\x12\x1d\n" +
+	"\n" +
+	"path_label\x18\x04 \x01(\tR\tpathLabel\x12G\n" +
+	"\fpath_pattern\x18\x05 \x01(\v2$.einride.decap.cms.v1.Widget.PatternR\vpathPattern\x12\x1d\n" +
+	"\n" +
+	"index_file\x18\x06 \x01(\tR\tindexFile\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\x1aL\n" +
+	"\x06Filter\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\x1aR\n" +
+	"\n" +
+	"ViewFilter\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\x1aQ\n" +
+	"\tViewGroup\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\x1a\x9b\x01\n" +
+	"\x04File\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x12\n" +
+	"\x04file\x18\x03 \x01(\tR\x04file\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x123\n" +
+	"\x06fields\x18\x05 \x03(\v2\x1b.einride.decap.cms.v1.FieldR\x06fields\"\x91\x01\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04YAML\x10\x01\x12\b\n" +
+	"\x04TOML\x10\x02\x12\b\n" +
+	"\x04JSON\x10\x03\x12\x0f\n" +
+	"\vFRONTMATTER\x10\x04\x12\x14\n" +
+	"\x10YAML_FRONTMATTER\x10\x05\x12\x14\n" +
+	"\x10TOML_FRONTMATTER\x10\x06\x12\x14\n" +
+	"\x10JSON_FRONTMATTER\x10\aB\t\n" +
+	"\a_deleteB\n" +
+	"\n" +
+	"\b_publish\"<\n" +
 	"\x05Owner\x12!\n" +
 	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x10\n" +
-	"\x03uri\x18\x02 \x01(\tR\x03uri\"\xcc\x01\n" +
+	"\x03uri\x18\x02 \x01(\tR\x03uri\"\xe0\x01\n" +
 	"\x05Field\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x18\n" +
 	"\acomment\x18\x03 \x01(\tR\acomment\x124\n" +
 	"\x06widget\x18\x04 \x01(\v2\x1c.einride.decap.cms.v1.WidgetR\x06widget\x12\x16\n" +
 	"\x06ignore\x18\x05 \x01(\bR\x06ignore\x121\n" +
-	"\x05owner\x18\x06 \x01(\v2\x1b.einride.decap.cms.v1.OwnerR\x05owner\"\xc4\v\n" +
+	"\x05owner\x18\x06 \x01(\v2\x1b.einride.decap.cms.v1.OwnerR\x05owner\x12\x12\n" +
+	"\x04body\x18\a \x01(\bR\x04body\"!\n" +
+	"\tEnumValue\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\"\xc4\v\n" +
 	"\x06Widget\x12%\n" +
 	"\x0erequired_value\x18\x01 \x01(\bR\rrequiredValue\x12\x12\n" +
 	"\x04hint\x18\x02 \x01(\tR\x04hint\x12>\n" +
@@ -2918,17 +3899,20 @@ const file_einride_decap_cms_v1_annotations_proto_rawDesc = "" +
 	"\aPattern\x12\x16\n" +
 	"\x06regexp\x18\x01 \x01(\tR\x06regexp\x12#\n" +
 	"\rerror_message\x18\x02 \x01(\tR\ferrorMessageB\r\n" +
-	"\vwidget_type\"@\n" +
+	"\vwidget_type\"\x88\x01\n" +
 	"\fCustomWidget\x12\x16\n" +
 	"\x06widget\x18\x01 \x01(\tR\x06widget\x12\x18\n" +
-	"\aoptions\x18\x02 \x03(\tR\aoptions\"4\n" +
-	"\rBooleanWidget\x12#\n" +
-	"\rdefault_value\x18\x01 \x01(\bR\fdefaultValue\"\xdc\x01\n" +
+	"\aoptions\x18\x02 \x03(\tR\aoptions\x12F\n" +
+	"\x12structured_options\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x11structuredOptions\"K\n" +
+	"\rBooleanWidget\x12(\n" +
+	"\rdefault_value\x18\x01 \x01(\bH\x00R\fdefaultValue\x88\x01\x01B\x10\n" +
+	"\x0e_default_value\"\x86\x02\n" +
 	"\n" +
 	"CodeWidget\x12)\n" +
 	"\x10default_language\x18\x01 \x01(\tR\x0fdefaultLanguage\x128\n" +
 	"\x18allow_language_selection\x18\x02 \x01(\bR\x16allowLanguageSelection\x129\n" +
-	"\x04keys\x18\x03 \x01(\v2%.einride.decap.cms.v1.CodeWidget.KeysR\x04keys\x1a.\n" +
+	"\x04keys\x18\x03 \x01(\v2%.einride.decap.cms.v1.CodeWidget.KeysR\x04keys\x12(\n" +
+	"\x10output_code_only\x18\x04 \x01(\bR\x0eoutputCodeOnly\x1a.\n" +
 	"\x04Keys\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
 	"\x04lang\x18\x02 \x01(\tR\x04lang\"v\n" +
@@ -2936,30 +3920,46 @@ const file_einride_decap_cms_v1_annotations_proto_rawDesc = "" +
 	"\rdefault_value\x18\x01 \x01(\tR\fdefaultValue\x12\x1f\n" +
 	"\vallow_input\x18\x02 \x01(\bR\n" +
 	"allowInput\x12!\n" +
-	"\fenable_alpha\x18\x03 \x01(\bR\venableAlpha\"\xae\x01\n" +
-	"\x0eDateTimeWidget\x12#\n" +
-	"\rdefault_value\x18\x01 \x01(\tR\fdefaultValue\x12\x16\n" +
+	"\fenable_alpha\x18\x03 \x01(\bR\venableAlpha\"\xa5\x02\n" +
+	"\x0eDateTimeWidget\x12(\n" +
+	"\rdefault_value\x18\x01 \x01(\tH\x00R\fdefaultValue\x88\x01\x01\x12\x16\n" +
 	"\x06format\x18\x02 \x01(\tR\x06format\x12\x1f\n" +
 	"\vdate_format\x18\x03 \x01(\tR\n" +
 	"dateFormat\x12\x1f\n" +
 	"\vtime_format\x18\x04 \x01(\tR\n" +
 	"timeFormat\x12\x1d\n" +
 	"\n" +
-	"picker_utc\x18\x05 \x01(\bR\tpickerUtc\"1\n" +
+	"picker_utc\x18\x05 \x01(\bR\tpickerUtc\x12.\n" +
+	"\x13disable_date_format\x18\x06 \x01(\bR\x11disableDateFormat\x12.\n" +
+	"\x13disable_time_format\x18\a \x01(\bR\x11disableTimeFormatB\x10\n" +
+	"\x0e_default_value\"\xc2\x01\n" +
 	"\n" +
 	"FileWidget\x12#\n" +
-	"\rdefault_value\x18\x01 \x01(\tR\fdefaultValue\"\xbd\x01\n" +
+	"\rdefault_value\x18\x01 \x01(\tR\fdefaultValue\x12G\n" +
+	"\rmedia_library\x18\x02 \x01(\v2\".einride.decap.cms.v1.MediaLibraryR\fmediaLibrary\x12!\n" +
+	"\fmedia_folder\x18\x03 \x01(\tR\vmediaFolder\x12#\n" +
+	"\rpublic_folder\x18\x04 \x01(\tR\fpublicFolder\"\xbd\x01\n" +
 	"\fHiddenWidget\x12#\n" +
 	"\fdefault_bool\x18\x01 \x01(\bH\x00R\vdefaultBool\x12'\n" +
 	"\x0edefault_string\x18\x02 \x01(\tH\x00R\rdefaultString\x12'\n" +
 	"\x0edefault_double\x18\x03 \x01(\x01H\x00R\rdefaultDouble\x12%\n" +
 	"\rdefault_int64\x18\x04 \x01(\x03H\x00R\fdefaultInt64B\x0f\n" +
-	"\rdefault_value\"2\n" +
+	"\rdefault_value\"\xc3\x01\n" +
 	"\vImageWidget\x12#\n" +
-	"\rdefault_value\x18\x01 \x01(\tR\fdefaultValue\"\xc4\x02\n" +
+	"\rdefault_value\x18\x01 \x01(\tR\fdefaultValue\x12G\n" +
+	"\rmedia_library\x18\x02 \x01(\v2\".einride.decap.cms.v1.MediaLibraryR\fmediaLibrary\x12!\n" +
+	"\fmedia_folder\x18\x03 \x01(\tR\vmediaFolder\x12#\n" +
+	"\rpublic_folder\x18\x04 \x01(\tR\fpublicFolder\"\xb1\x01\n" +
+	"\fMediaLibrary\x12*\n" +
+	"\x0eallow_multiple\x18\x01 \x01(\bH\x00R\rallowMultiple\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"ListWidget\x12\x1b\n" +
-	"\tallow_add\x18\x01 \x01(\bR\ballowAdd\x12\x1c\n" +
+	"choose_url\x18\x02 \x01(\bH\x01R\tchooseUrl\x88\x01\x01\x12/\n" +
+	"\x06config\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x06configB\x11\n" +
+	"\x0f_allow_multipleB\r\n" +
+	"\v_choose_url\"\xd8\x03\n" +
+	"\n" +
+	"ListWidget\x12 \n" +
+	"\tallow_add\x18\x01 \x01(\bH\x00R\ballowAdd\x88\x01\x01\x12\x1c\n" +
 	"\tcollapsed\x18\x02 \x01(\bR\tcollapsed\x12\x18\n" +
 	"\asummary\x18\x03 \x01(\tR\asummary\x12-\n" +
 	"\x12minimize_collapsed\x18\x04 \x01(\bR\x11minimizeCollapsed\x12%\n" +
@@ -2968,7 +3968,13 @@ const file_einride_decap_cms_v1_annotations_proto_rawDesc = "" +
 	"\tmax_items\x18\a \x01(\x03R\bmaxItems\x12\x1b\n" +
 	"\tmin_items\x18\b \x01(\x03R\bminItems\x12\x1c\n" +
 	"\n" +
-	"add_to_top\x18\t \x01(\bR\baddToTop\"\xcd\x01\n" +
+	"add_to_top\x18\t \x01(\bR\baddToTop\x121\n" +
+	"\x05field\x18\n" +
+	" \x01(\v2\x1b.einride.decap.cms.v1.FieldR\x05field\x121\n" +
+	"\x05types\x18\v \x03(\v2\x1b.einride.decap.cms.v1.FieldR\x05types\x12\x19\n" +
+	"\btype_key\x18\f \x01(\tR\atypeKeyB\f\n" +
+	"\n" +
+	"_allow_add\"\xcd\x01\n" +
 	"\tMapWidget\x12\x1a\n" +
 	"\bdecimals\x18\x01 \x01(\x03R\bdecimals\x12#\n" +
 	"\rdefault_value\x18\x02 \x01(\tR\fdefaultValue\x128\n" +
@@ -2980,20 +3986,26 @@ const file_einride_decap_cms_v1_annotations_proto_rawDesc = "" +
 	"\aPOLYGON\x10\x03\"O\n" +
 	"\x0eMarkdownWidget\x12#\n" +
 	"\rdefault_value\x18\x01 \x01(\tR\fdefaultValue\x12\x18\n" +
-	"\aminimal\x18\x02 \x01(\bR\aminimal\"\x97\x02\n" +
+	"\aminimal\x18\x02 \x01(\bR\aminimal\"\xde\x02\n" +
 	"\fNumberWidget\x12#\n" +
 	"\rdefault_value\x18\x01 \x01(\x01R\fdefaultValue\x12K\n" +
 	"\n" +
-	"value_type\x18\x02 \x01(\x0e2,.einride.decap.cms.v1.NumberWidget.ValueTypeR\tvalueType\x12\x1b\n" +
-	"\tmin_value\x18\x03 \x01(\x01R\bminValue\x12\x1b\n" +
-	"\tmax_value\x18\x04 \x01(\x01R\bmaxValue\x12\x12\n" +
-	"\x04step\x18\x05 \x01(\x01R\x04step\"G\n" +
+	"value_type\x18\x02 \x01(\x0e2,.einride.decap.cms.v1.NumberWidget.ValueTypeR\tvalueType\x12 \n" +
+	"\tmin_value\x18\x03 \x01(\x01H\x00R\bminValue\x88\x01\x01\x12 \n" +
+	"\tmax_value\x18\x04 \x01(\x01H\x01R\bmaxValue\x88\x01\x01\x12\x12\n" +
+	"\x04step\x18\x05 \x01(\x01R\x04step\x12\x1f\n" +
+	"\vallow_empty\x18\x06 \x01(\bR\n" +
+	"allowEmpty\"G\n" +
 	"\tValueType\x12\x1a\n" +
 	"\x16VALUE_TYPE_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03INT\x10\x01\x12\t\n" +
 	"\x05FLOAT\x10\x02\x12\n" +
 	"\n" +
-	"\x06STRING\x10\x03\"{\n" +
+	"\x06STRING\x10\x03B\f\n" +
+	"\n" +
+	"_min_valueB\f\n" +
+	"\n" +
+	"_max_value\"{\n" +
 	"\fObjectWidget\x12\x1c\n" +
 	"\tcollapsed\x18\x01 \x01(\bR\tcollapsed\x12\x18\n" +
 	"\asummary\x18\x02 \x01(\tR\asummary\x123\n" +
@@ -3029,7 +4041,9 @@ const file_einride_decap_cms_v1_annotations_proto_rawDesc = "" +
 	"\n" +
 	"collection\x12\x1f.google.protobuf.MessageOptions\x18\xeb\x9e\xfe\" \x01(\v2 .einride.decap.cms.v1.CollectionR\n" +
 	"collection:S\n" +
-	"\x05field\x12\x1d.google.protobuf.FieldOptions\x18垴~ \x01(\v2\x1b.einride.decap.cms.v1.FieldR\x05fieldB\xeb\x01\n" +
+	"\x05field\x12\x1d.google.protobuf.FieldOptions\x18垴~ \x01(\v2\x1b.einride.decap.cms.v1.FieldR\x05field:d\n" +
+	"\n" +
+	"enum_value\x12!.google.protobuf.EnumValueOptions\x18\xcc\xed\xf2. \x01(\v2\x1f.einride.decap.cms.v1.EnumValueR\tenumValueB\xeb\x01\n" +
 	"\x18com.einride.decap.cms.v1B\x10AnnotationsProtoP\x01ZJgo.einride.tech/protobuf-decap-cms/proto/gen/go/einride/decap/cms/v1;cmsv1\xa2\x02\x03EDC\xaa\x02\x14Einride.Decap.Cms.V1\xca\x02\x14Einride\\Decap\\Cms\\V1\xe2\x02 Einride\\Decap\\Cms\\V1\\GPBMetadata\xea\x02\x17Einride::Decap::Cms::V1b\x06proto3"
 
 var (
@@ -3044,97 +4058,123 @@ func file_einride_decap_cms_v1_annotations_proto_rawDescGZIP() []byte {
 	return file_einride_decap_cms_v1_annotations_proto_rawDescData
 }
 
-var file_einride_decap_cms_v1_annotations_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_einride_decap_cms_v1_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_einride_decap_cms_v1_annotations_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_einride_decap_cms_v1_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_einride_decap_cms_v1_annotations_proto_goTypes = []any{
 	(Config_PublishMode)(0),               // 0: einride.decap.cms.v1.Config.PublishMode
 	(Config_Slug_Encoding)(0),             // 1: einride.decap.cms.v1.Config.Slug.Encoding
-	(MapWidget_Type)(0),                   // 2: einride.decap.cms.v1.MapWidget.Type
-	(NumberWidget_ValueType)(0),           // 3: einride.decap.cms.v1.NumberWidget.ValueType
-	(*Config)(nil),                        // 4: einride.decap.cms.v1.Config
-	(*Collection)(nil),                    // 5: einride.decap.cms.v1.Collection
-	(*Owner)(nil),                         // 6: einride.decap.cms.v1.Owner
-	(*Field)(nil),                         // 7: einride.decap.cms.v1.Field
-	(*Widget)(nil),                        // 8: einride.decap.cms.v1.Widget
-	(*CustomWidget)(nil),                  // 9: einride.decap.cms.v1.CustomWidget
-	(*BooleanWidget)(nil),                 // 10: einride.decap.cms.v1.BooleanWidget
-	(*CodeWidget)(nil),                    // 11: einride.decap.cms.v1.CodeWidget
-	(*ColorWidget)(nil),                   // 12: einride.decap.cms.v1.ColorWidget
-	(*DateTimeWidget)(nil),                // 13: einride.decap.cms.v1.DateTimeWidget
-	(*FileWidget)(nil),                    // 14: einride.decap.cms.v1.FileWidget
-	(*HiddenWidget)(nil),                  // 15: einride.decap.cms.v1.HiddenWidget
-	(*ImageWidget)(nil),                   // 16: einride.decap.cms.v1.ImageWidget
-	(*ListWidget)(nil),                    // 17: einride.decap.cms.v1.ListWidget
-	(*MapWidget)(nil),                     // 18: einride.decap.cms.v1.MapWidget
-	(*MarkdownWidget)(nil),                // 19: einride.decap.cms.v1.MarkdownWidget
-	(*NumberWidget)(nil),                  // 20: einride.decap.cms.v1.NumberWidget
-	(*ObjectWidget)(nil),                  // 21: einride.decap.cms.v1.ObjectWidget
-	(*RelationWidget)(nil),                // 22: einride.decap.cms.v1.RelationWidget
-	(*SelectWidget)(nil),                  // 23: einride.decap.cms.v1.SelectWidget
-	(*StringWidget)(nil),                  // 24: einride.decap.cms.v1.StringWidget
-	(*TextWidget)(nil),                    // 25: einride.decap.cms.v1.TextWidget
-	(*Config_Backend)(nil),                // 26: einride.decap.cms.v1.Config.Backend
-	(*Config_LocalBackend)(nil),           // 27: einride.decap.cms.v1.Config.LocalBackend
-	(*Config_Slug)(nil),                   // 28: einride.decap.cms.v1.Config.Slug
-	(*Config_Backend_CommitMessages)(nil), // 29: einride.decap.cms.v1.Config.Backend.CommitMessages
-	(*Collection_Editor)(nil),             // 30: einride.decap.cms.v1.Collection.Editor
-	(*Widget_Pattern)(nil),                // 31: einride.decap.cms.v1.Widget.Pattern
-	(*CodeWidget_Keys)(nil),               // 32: einride.decap.cms.v1.CodeWidget.Keys
-	(*RelationWidget_Filter)(nil),         // 33: einride.decap.cms.v1.RelationWidget.Filter
-	(*SelectWidget_Option)(nil),           // 34: einride.decap.cms.v1.SelectWidget.Option
-	(*descriptorpb.FileOptions)(nil),      // 35: google.protobuf.FileOptions
-	(*descriptorpb.MessageOptions)(nil),   // 36: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),     // 37: google.protobuf.FieldOptions
+	(Collection_Format)(0),                // 2: einride.decap.cms.v1.Collection.Format
+	(MapWidget_Type)(0),                   // 3: einride.decap.cms.v1.MapWidget.Type
+	(NumberWidget_ValueType)(0),           // 4: einride.decap.cms.v1.NumberWidget.ValueType
+	(*Config)(nil),                        // 5: einride.decap.cms.v1.Config
+	(*Collection)(nil),                    // 6: einride.decap.cms.v1.Collection
+	(*Owner)(nil),                         // 7: einride.decap.cms.v1.Owner
+	(*Field)(nil),                         // 8: einride.decap.cms.v1.Field
+	(*EnumValue)(nil),                     // 9: einride.decap.cms.v1.EnumValue
+	(*Widget)(nil),                        // 10: einride.decap.cms.v1.Widget
+	(*CustomWidget)(nil),                  // 11: einride.decap.cms.v1.CustomWidget
+	(*BooleanWidget)(nil),                 // 12: einride.decap.cms.v1.BooleanWidget
+	(*CodeWidget)(nil),                    // 13: einride.decap.cms.v1.CodeWidget
+	(*ColorWidget)(nil),                   // 14: einride.decap.cms.v1.ColorWidget
+	(*DateTimeWidget)(nil),                // 15: einride.decap.cms.v1.DateTimeWidget
+	(*FileWidget)(nil),                    // 16: einride.decap.cms.v1.FileWidget
+	(*HiddenWidget)(nil),                  // 17: einride.decap.cms.v1.HiddenWidget
+	(*ImageWidget)(nil),                   // 18: einride.decap.cms.v1.ImageWidget
+	(*MediaLibrary)(nil),                  // 19: einride.decap.cms.v1.MediaLibrary
+	(*ListWidget)(nil),                    // 20: einride.decap.cms.v1.ListWidget
+	(*MapWidget)(nil),                     // 21: einride.decap.cms.v1.MapWidget
+	(*MarkdownWidget)(nil),                // 22: einride.decap.cms.v1.MarkdownWidget
+	(*NumberWidget)(nil),                  // 23: einride.decap.cms.v1.NumberWidget
+	(*ObjectWidget)(nil),                  // 24: einride.decap.cms.v1.ObjectWidget
+	(*RelationWidget)(nil),                // 25: einride.decap.cms.v1.RelationWidget
+	(*SelectWidget)(nil),                  // 26: einride.decap.cms.v1.SelectWidget
+	(*StringWidget)(nil),                  // 27: einride.decap.cms.v1.StringWidget
+	(*TextWidget)(nil),                    // 28: einride.decap.cms.v1.TextWidget
+	(*Config_Backend)(nil),                // 29: einride.decap.cms.v1.Config.Backend
+	(*Config_LocalBackend)(nil),           // 30: einride.decap.cms.v1.Config.LocalBackend
+	(*Config_Slug)(nil),                   // 31: einride.decap.cms.v1.Config.Slug
+	(*Config_Backend_CommitMessages)(nil), // 32: einride.decap.cms.v1.Config.Backend.CommitMessages
+	(*Collection_Editor)(nil),             // 33: einride.decap.cms.v1.Collection.Editor
+	(*Collection_Nested)(nil),             // 34: einride.decap.cms.v1.Collection.Nested
+	(*Collection_Filter)(nil),             // 35: einride.decap.cms.v1.Collection.Filter
+	(*Collection_ViewFilter)(nil),         // 36: einride.decap.cms.v1.Collection.ViewFilter
+	(*Collection_ViewGroup)(nil),          // 37: einride.decap.cms.v1.Collection.ViewGroup
+	(*Collection_File)(nil),               // 38: einride.decap.cms.v1.Collection.File
+	(*Widget_Pattern)(nil),                // 39: einride.decap.cms.v1.Widget.Pattern
+	(*CodeWidget_Keys)(nil),               // 40: einride.decap.cms.v1.CodeWidget.Keys
+	(*RelationWidget_Filter)(nil),         // 41: einride.decap.cms.v1.RelationWidget.Filter
+	(*SelectWidget_Option)(nil),           // 42: einride.decap.cms.v1.SelectWidget.Option
+	(*structpb.Struct)(nil),               // 43: google.protobuf.Struct
+	(*descriptorpb.FileOptions)(nil),      // 44: google.protobuf.FileOptions
+	(*descriptorpb.MessageOptions)(nil),   // 45: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),     // 46: google.protobuf.FieldOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 47: google.protobuf.EnumValueOptions
 }
 var file_einride_decap_cms_v1_annotations_proto_depIdxs = []int32{
-	26, // 0: einride.decap.cms.v1.Config.backend:type_name -> einride.decap.cms.v1.Config.Backend
-	27, // 1: einride.decap.cms.v1.Config.local_backend:type_name -> einride.decap.cms.v1.Config.LocalBackend
+	29, // 0: einride.decap.cms.v1.Config.backend:type_name -> einride.decap.cms.v1.Config.Backend
+	30, // 1: einride.decap.cms.v1.Config.local_backend:type_name -> einride.decap.cms.v1.Config.LocalBackend
 	0,  // 2: einride.decap.cms.v1.Config.publish_mode:type_name -> einride.decap.cms.v1.Config.PublishMode
-	28, // 3: einride.decap.cms.v1.Config.slug:type_name -> einride.decap.cms.v1.Config.Slug
-	5,  // 4: einride.decap.cms.v1.Config.collections:type_name -> einride.decap.cms.v1.Collection
-	30, // 5: einride.decap.cms.v1.Collection.editor:type_name -> einride.decap.cms.v1.Collection.Editor
-	7,  // 6: einride.decap.cms.v1.Collection.fields:type_name -> einride.decap.cms.v1.Field
-	6,  // 7: einride.decap.cms.v1.Collection.owner:type_name -> einride.decap.cms.v1.Owner
-	8,  // 8: einride.decap.cms.v1.Field.widget:type_name -> einride.decap.cms.v1.Widget
-	6,  // 9: einride.decap.cms.v1.Field.owner:type_name -> einride.decap.cms.v1.Owner
-	31, // 10: einride.decap.cms.v1.Widget.pattern:type_name -> einride.decap.cms.v1.Widget.Pattern
-	10, // 11: einride.decap.cms.v1.Widget.boolean_widget:type_name -> einride.decap.cms.v1.BooleanWidget
-	11, // 12: einride.decap.cms.v1.Widget.code_widget:type_name -> einride.decap.cms.v1.CodeWidget
-	12, // 13: einride.decap.cms.v1.Widget.color_widget:type_name -> einride.decap.cms.v1.ColorWidget
-	13, // 14: einride.decap.cms.v1.Widget.date_time_widget:type_name -> einride.decap.cms.v1.DateTimeWidget
-	14, // 15: einride.decap.cms.v1.Widget.file_widget:type_name -> einride.decap.cms.v1.FileWidget
-	15, // 16: einride.decap.cms.v1.Widget.hidden_widget:type_name -> einride.decap.cms.v1.HiddenWidget
-	16, // 17: einride.decap.cms.v1.Widget.image_widget:type_name -> einride.decap.cms.v1.ImageWidget
-	17, // 18: einride.decap.cms.v1.Widget.list_widget:type_name -> einride.decap.cms.v1.ListWidget
-	18, // 19: einride.decap.cms.v1.Widget.map_widget:type_name -> einride.decap.cms.v1.MapWidget
-	19, // 20: einride.decap.cms.v1.Widget.markdown_widget:type_name -> einride.decap.cms.v1.MarkdownWidget
-	20, // 21: einride.decap.cms.v1.Widget.number_widget:type_name -> einride.decap.cms.v1.NumberWidget
-	21, // 22: einride.decap.cms.v1.Widget.object_widget:type_name -> einride.decap.cms.v1.ObjectWidget
-	22, // 23: einride.decap.cms.v1.Widget.relation_widget:type_name -> einride.decap.cms.v1.RelationWidget
-	23, // 24: einride.decap.cms.v1.Widget.select_widget:type_name -> einride.decap.cms.v1.SelectWidget
-	24, // 25: einride.decap.cms.v1.Widget.string_widget:type_name -> einride.decap.cms.v1.StringWidget
-	25, // 26: einride.decap.cms.v1.Widget.text_widget:type_name -> einride.decap.cms.v1.TextWidget
-	9,  // 27: einride.decap.cms.v1.Widget.custom_widget:type_name -> einride.decap.cms.v1.CustomWidget
-	32, // 28: einride.decap.cms.v1.CodeWidget.keys:type_name -> einride.decap.cms.v1.CodeWidget.Keys
-	7,  // 29: einride.decap.cms.v1.ListWidget.fields:type_name -> einride.decap.cms.v1.Field
-	2,  // 30: einride.decap.cms.v1.MapWidget.type:type_name -> einride.decap.cms.v1.MapWidget.Type
-	3,  // 31: einride.decap.cms.v1.NumberWidget.value_type:type_name -> einride.decap.cms.v1.NumberWidget.ValueType
-	7,  // 32: einride.decap.cms.v1.ObjectWidget.fields:type_name -> einride.decap.cms.v1.Field
-	33, // 33: einride.decap.cms.v1.RelationWidget.filters:type_name -> einride.decap.cms.v1.RelationWidget.Filter
-	34, // 34: einride.decap.cms.v1.SelectWidget.options:type_name -> einride.decap.cms.v1.SelectWidget.Option
-	29, // 35: einride.decap.cms.v1.Config.Backend.commit_messages:type_name -> einride.decap.cms.v1.Config.Backend.CommitMessages
-	1,  // 36: einride.decap.cms.v1.Config.Slug.encoding:type_name -> einride.decap.cms.v1.Config.Slug.Encoding
-	35, // 37: einride.decap.cms.v1.config:extendee -> google.protobuf.FileOptions
-	36, // 38: einride.decap.cms.v1.collection:extendee -> google.protobuf.MessageOptions
-	37, // 39: einride.decap.cms.v1.field:extendee -> google.protobuf.FieldOptions
-	4,  // 40: einride.decap.cms.v1.config:type_name -> einride.decap.cms.v1.Config
-	5,  // 41: einride.decap.cms.v1.collection:type_name -> einride.decap.cms.v1.Collection
-	7,  // 42: einride.decap.cms.v1.field:type_name -> einride.decap.cms.v1.Field
-	43, // [43:43] is the sub-list for method output_type
-	43, // [43:43] is the sub-list for method input_type
-	40, // [40:43] is the sub-list for extension type_name
-	37, // [37:40] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	31, // 3: einride.decap.cms.v1.Config.slug:type_name -> einride.decap.cms.v1.Config.Slug
+	6,  // 4: einride.decap.cms.v1.Config.collections:type_name -> einride.decap.cms.v1.Collection
+	33, // 5: einride.decap.cms.v1.Collection.editor:type_name -> einride.decap.cms.v1.Collection.Editor
+	8,  // 6: einride.decap.cms.v1.Collection.fields:type_name -> einride.decap.cms.v1.Field
+	7,  // 7: einride.decap.cms.v1.Collection.owner:type_name -> einride.decap.cms.v1.Owner
+	34, // 8: einride.decap.cms.v1.Collection.nested:type_name -> einride.decap.cms.v1.Collection.Nested
+	38, // 9: einride.decap.cms.v1.Collection.files:type_name -> einride.decap.cms.v1.Collection.File
+	36, // 10: einride.decap.cms.v1.Collection.view_filters:type_name -> einride.decap.cms.v1.Collection.ViewFilter
+	37, // 11: einride.decap.cms.v1.Collection.view_groups:type_name -> einride.decap.cms.v1.Collection.ViewGroup
+	35, // 12: einride.decap.cms.v1.Collection.filter:type_name -> einride.decap.cms.v1.Collection.Filter
+	2,  // 13: einride.decap.cms.v1.Collection.file_format:type_name -> einride.decap.cms.v1.Collection.Format
+	10, // 14: einride.decap.cms.v1.Field.widget:type_name -> einride.decap.cms.v1.Widget
+	7,  // 15: einride.decap.cms.v1.Field.owner:type_name -> einride.decap.cms.v1.Owner
+	39, // 16: einride.decap.cms.v1.Widget.pattern:type_name -> einride.decap.cms.v1.Widget.Pattern
+	12, // 17: einride.decap.cms.v1.Widget.boolean_widget:type_name -> einride.decap.cms.v1.BooleanWidget
+	13, // 18: einride.decap.cms.v1.Widget.code_widget:type_name -> einride.decap.cms.v1.CodeWidget
+	14, // 19: einride.decap.cms.v1.Widget.color_widget:type_name -> einride.decap.cms.v1.ColorWidget
+	15, // 20: einride.decap.cms.v1.Widget.date_time_widget:type_name -> einride.decap.cms.v1.DateTimeWidget
+	16, // 21: einride.decap.cms.v1.Widget.file_widget:type_name -> einride.decap.cms.v1.FileWidget
+	17, // 22: einride.decap.cms.v1.Widget.hidden_widget:type_name -> einride.decap.cms.v1.HiddenWidget
+	18, // 23: einride.decap.cms.v1.Widget.image_widget:type_name -> einride.decap.cms.v1.ImageWidget
+	20, // 24: einride.decap.cms.v1.Widget.list_widget:type_name -> einride.decap.cms.v1.ListWidget
+	21, // 25: einride.decap.cms.v1.Widget.map_widget:type_name -> einride.decap.cms.v1.MapWidget
+	22, // 26: einride.decap.cms.v1.Widget.markdown_widget:type_name -> einride.decap.cms.v1.MarkdownWidget
+	23, // 27: einride.decap.cms.v1.Widget.number_widget:type_name -> einride.decap.cms.v1.NumberWidget
+	24, // 28: einride.decap.cms.v1.Widget.object_widget:type_name -> einride.decap.cms.v1.ObjectWidget
+	25, // 29: einride.decap.cms.v1.Widget.relation_widget:type_name -> einride.decap.cms.v1.RelationWidget
+	26, // 30: einride.decap.cms.v1.Widget.select_widget:type_name -> einride.decap.cms.v1.SelectWidget
+	27, // 31: einride.decap.cms.v1.Widget.string_widget:type_name -> einride.decap.cms.v1.StringWidget
+	28, // 32: einride.decap.cms.v1.Widget.text_widget:type_name -> einride.decap.cms.v1.TextWidget
+	11, // 33: einride.decap.cms.v1.Widget.custom_widget:type_name -> einride.decap.cms.v1.CustomWidget
+	43, // 34: einride.decap.cms.v1.CustomWidget.structured_options:type_name -> google.protobuf.Struct
+	40, // 35: einride.decap.cms.v1.CodeWidget.keys:type_name -> einride.decap.cms.v1.CodeWidget.Keys
+	19, // 36: einride.decap.cms.v1.FileWidget.media_library:type_name -> einride.decap.cms.v1.MediaLibrary
+	19, // 37: einride.decap.cms.v1.ImageWidget.media_library:type_name -> einride.decap.cms.v1.MediaLibrary
+	43, // 38: einride.decap.cms.v1.MediaLibrary.config:type_name -> google.protobuf.Struct
+	8,  // 39: einride.decap.cms.v1.ListWidget.fields:type_name -> einride.decap.cms.v1.Field
+	8,  // 40: einride.decap.cms.v1.ListWidget.field:type_name -> einride.decap.cms.v1.Field
+	8,  // 41: einride.decap.cms.v1.ListWidget.types:type_name -> einride.decap.cms.v1.Field
+	3,  // 42: einride.decap.cms.v1.MapWidget.type:type_name -> einride.decap.cms.v1.MapWidget.Type
+	4,  // 43: einride.decap.cms.v1.NumberWidget.value_type:type_name -> einride.decap.cms.v1.NumberWidget.ValueType
+	8,  // 44: einride.decap.cms.v1.ObjectWidget.fields:type_name -> einride.decap.cms.v1.Field
+	41, // 45: einride.decap.cms.v1.RelationWidget.filters:type_name -> einride.decap.cms.v1.RelationWidget.Filter
+	42, // 46: einride.decap.cms.v1.SelectWidget.options:type_name -> einride.decap.cms.v1.SelectWidget.Option
+	32, // 47: einride.decap.cms.v1.Config.Backend.commit_messages:type_name -> einride.decap.cms.v1.Config.Backend.CommitMessages
+	1,  // 48: einride.decap.cms.v1.Config.Slug.encoding:type_name -> einride.decap.cms.v1.Config.Slug.Encoding
+	39, // 49: einride.decap.cms.v1.Collection.Nested.path_pattern:type_name -> einride.decap.cms.v1.Widget.Pattern
+	8,  // 50: einride.decap.cms.v1.Collection.File.fields:type_name -> einride.decap.cms.v1.Field
+	44, // 51: einride.decap.cms.v1.config:extendee -> google.protobuf.FileOptions
+	45, // 52: einride.decap.cms.v1.collection:extendee -> google.protobuf.MessageOptions
+	46, // 53: einride.decap.cms.v1.field:extendee -> google.protobuf.FieldOptions
+	47, // 54: einride.decap.cms.v1.enum_value:extendee -> google.protobuf.EnumValueOptions
+	5,  // 55: einride.decap.cms.v1.config:type_name -> einride.decap.cms.v1.Config
+	6,  // 56: einride.decap.cms.v1.collection:type_name -> einride.decap.cms.v1.Collection
+	8,  // 57: einride.decap.cms.v1.field:type_name -> einride.decap.cms.v1.Field
+	9,  // 58: einride.decap.cms.v1.enum_value:type_name -> einride.decap.cms.v1.EnumValue
+	59, // [59:59] is the sub-list for method output_type
+	59, // [59:59] is the sub-list for method input_type
+	55, // [55:59] is the sub-list for extension type_name
+	51, // [51:55] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_einride_decap_cms_v1_annotations_proto_init() }
@@ -3142,7 +4182,8 @@ func file_einride_decap_cms_v1_annotations_proto_init() {
 	if File_einride_decap_cms_v1_annotations_proto != nil {
 		return
 	}
-	file_einride_decap_cms_v1_annotations_proto_msgTypes[4].OneofWrappers = []any{
+	file_einride_decap_cms_v1_annotations_proto_msgTypes[1].OneofWrappers = []any{}
+	file_einride_decap_cms_v1_annotations_proto_msgTypes[5].OneofWrappers = []any{
 		(*Widget_BooleanWidget)(nil),
 		(*Widget_CodeWidget)(nil),
 		(*Widget_ColorWidget)(nil),
@@ -3161,20 +4202,25 @@ func file_einride_decap_cms_v1_annotations_proto_init() {
 		(*Widget_TextWidget)(nil),
 		(*Widget_CustomWidget)(nil),
 	}
-	file_einride_decap_cms_v1_annotations_proto_msgTypes[11].OneofWrappers = []any{
+	file_einride_decap_cms_v1_annotations_proto_msgTypes[7].OneofWrappers = []any{}
+	file_einride_decap_cms_v1_annotations_proto_msgTypes[10].OneofWrappers = []any{}
+	file_einride_decap_cms_v1_annotations_proto_msgTypes[12].OneofWrappers = []any{
 		(*HiddenWidget_DefaultBool)(nil),
 		(*HiddenWidget_DefaultString)(nil),
 		(*HiddenWidget_DefaultDouble)(nil),
 		(*HiddenWidget_DefaultInt64)(nil),
 	}
+	file_einride_decap_cms_v1_annotations_proto_msgTypes[14].OneofWrappers = []any{}
+	file_einride_decap_cms_v1_annotations_proto_msgTypes[15].OneofWrappers = []any{}
+	file_einride_decap_cms_v1_annotations_proto_msgTypes[18].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_einride_decap_cms_v1_annotations_proto_rawDesc), len(file_einride_decap_cms_v1_annotations_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   31,
-			NumExtensions: 3,
+			NumEnums:      5,
+			NumMessages:   38,
+			NumExtensions: 4,
 			NumServices:   0,
 		},
 		GoTypes:           file_einride_decap_cms_v1_annotations_proto_goTypes,